@@ -7,6 +7,9 @@ toolchain go1.24.5
 require (
 	github.com/ovh/go-ovh v1.9.0
 	github.com/turbot/steampipe-plugin-sdk/v5 v5.13.1
+	golang.org/x/sync v0.12.0
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/ini.v1 v1.67.0
 )
 
 require (
@@ -99,7 +102,6 @@ require (
 	golang.org/x/mod v0.19.0 // indirect
 	golang.org/x/net v0.38.0 // indirect
 	golang.org/x/oauth2 v0.27.0 // indirect
-	golang.org/x/sync v0.12.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
 	golang.org/x/text v0.23.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
@@ -109,7 +111,5 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20240604185151-ef581f913117 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117 // indirect
 	google.golang.org/grpc v1.66.0 // indirect
-	google.golang.org/protobuf v1.34.2 // indirect
-	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
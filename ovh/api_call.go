@@ -0,0 +1,168 @@
+package ovh
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// maxApiCalls is the number of API calls kept in memory, the oldest calls are dropped first.
+const maxApiCalls = 1000
+
+// ApiCall is an API call made by the plugin.
+type ApiCall struct {
+	Connection string
+	Table      string
+	StartedAt  time.Time
+	Method     string
+	Path       string
+	Route      string
+	Status     int
+	Error      string
+	QueryID    string
+	Latency    time.Duration
+	Bytes      int64
+	RetryCount int
+}
+
+func (call ApiCall) LatencyMs() float64 {
+	return float64(call.Latency) / float64(time.Millisecond)
+}
+
+// apiCallRing keeps the last API calls of the plugin.
+type apiCallRing struct {
+	mu    sync.Mutex
+	size  int
+	calls []ApiCall
+	next  int
+	// failures counts the consecutive retryable failures of each request, the
+	// retries of retryTransport send the same request again
+	failures map[string]int
+}
+
+func newApiCallRing(size int) *apiCallRing {
+	return &apiCallRing{size: size, failures: map[string]int{}}
+}
+
+// apiCalls are the API calls of every connection of the plugin.
+var apiCalls = newApiCallRing(maxApiCalls)
+
+// add adds the call to the ring, the retry count of the call is set from the previous
+// failures of the request identified by key.
+func (r *apiCallRing) add(call ApiCall, key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	call.RetryCount = r.failures[key]
+	if call.Status == http.StatusTooManyRequests || call.Status >= http.StatusInternalServerError {
+		if len(r.failures) >= r.size {
+			// the requests never retried are forgotten
+			clear(r.failures)
+		}
+		r.failures[key] = call.RetryCount + 1
+	} else {
+		delete(r.failures, key)
+	}
+
+	if len(r.calls) < r.size {
+		r.calls = append(r.calls, call)
+		return
+	}
+	r.calls[r.next] = call
+	r.next = (r.next + 1) % r.size
+}
+
+// list returns the calls of the connection, from the oldest to the newest.
+func (r *apiCallRing) list(connection string) []ApiCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []ApiCall
+	for i := range r.calls {
+		call := r.calls[(r.next+i)%len(r.calls)]
+		if call.Connection == connection {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// apiCallTransport sends the requests with the next transport and adds each call,
+// with the table that made it, to apiCalls.
+type apiCallTransport struct {
+	connection string
+	table      string
+	// basePath is the path of the endpoint, the routes are relative to it
+	basePath string
+	next     http.RoundTripper
+}
+
+func (t *apiCallTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := apiPath(t.basePath, req.URL.Path)
+	call := ApiCall{
+		Connection: t.connection,
+		Table:      t.table,
+		StartedAt:  time.Now(),
+		Method:     req.Method,
+		Path:       path,
+		Route:      apiRoute(path),
+	}
+	key := strings.Join([]string{t.connection, t.table, req.Method, req.URL.String()}, " ")
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		call.Latency = time.Since(call.StartedAt)
+		call.Error = err.Error()
+		apiCalls.add(call, key)
+		return nil, err
+	}
+	// the body is read to measure the whole call
+	body, err := readBody(&resp.Body)
+	call.Latency = time.Since(call.StartedAt)
+	call.Status = resp.StatusCode
+	call.QueryID = resp.Header.Get("X-Ovh-QueryId")
+	call.Bytes = int64(len(body))
+	if err != nil {
+		call.Error = err.Error()
+		apiCalls.add(call, key)
+		return nil, err
+	}
+	apiCalls.add(call, key)
+	return resp, nil
+}
+
+// apiPath returns the path of the request relative to the endpoint, the /v1 and /v2
+// paths are relative to the root of the /1.0 endpoints.
+func apiPath(basePath, path string) string {
+	if relative, ok := strings.CutPrefix(path, basePath+"/"); ok {
+		return "/" + relative
+	}
+	return strings.TrimPrefix(path, strings.TrimSuffix(basePath, "/1.0"))
+}
+
+// apiRoute returns the route of a path, its identifiers replaced by {id}. The API paths
+// have no identifier in their first segment and their other fixed segments are lowerCamelCase
+// words, the segments with a digit, a character other than a letter or no lowercase letter
+// (such as the GRA or BHS regions) are identifiers.
+func apiRoute(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if i < 2 || segment == "" {
+			continue
+		}
+		lower := false
+		for _, c := range segment {
+			if !unicode.IsLetter(c) || c > unicode.MaxASCII {
+				lower = false
+				break
+			}
+			lower = lower || unicode.IsLower(c)
+		}
+		if !lower {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
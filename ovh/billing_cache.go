@@ -0,0 +1,158 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// billingCache is the on-disk cache of the billing documents (bills, refunds and their details),
+// they never change once issued. The API responses are stored by account and API path,
+// /me/bill/FR0001 of the account xx1234-ovh is stored in <dir>/xx1234-ovh/me/bill/FR0001.json.
+type billingCache struct {
+	dir string
+}
+
+// getBillingCache returns the billing cache of the connection, nil when 'billing_cache_dir' is not set.
+func getBillingCache(ctx context.Context, d *plugin.QueryData) (*billingCache, error) {
+	dir := stringValue(GetConfig(d.Connection).BillingCacheDir)
+	if dir == "" {
+		return nil, nil
+	}
+	account, err := getAccount(ctx, d, &plugin.HydrateData{})
+	if err != nil {
+		return nil, err
+	}
+	return &billingCache{dir: filepath.Join(dir, url.PathEscape(account.(string)))}, nil
+}
+
+// filename returns the file of an API path, false for the paths that cannot be a file of the cache.
+func (c *billingCache) filename(path string) (string, bool) {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", false
+		}
+		segments[i] = url.PathEscape(segment)
+	}
+	return filepath.Join(c.dir, filepath.Join(segments...)+".json"), true
+}
+
+// get returns the cached response of the API path, nil if it is not cached.
+func (c *billingCache) get(path string) json.RawMessage {
+	if c == nil {
+		return nil
+	}
+	filename, ok := c.filename(path)
+	if !ok {
+		return nil
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil || !json.Valid(content) {
+		return nil
+	}
+	return content
+}
+
+// billingCacheOmittedFields are the fields of the billing documents not stored in the cache,
+// the download links are signed and the password gives access to the document.
+var billingCacheOmittedFields = []string{"password", "pdfUrl", "url"}
+
+// omitBillingFields removes billingCacheOmittedFields from a billing document, or from each
+// document of a batch response. Other contents are returned as is.
+func omitBillingFields(content []byte) ([]byte, error) {
+	var batch []batchResult[json.RawMessage]
+	if err := json.Unmarshal(content, &batch); err == nil {
+		for i := range batch {
+			value, err := omitBillingFields(batch[i].Value)
+			if err != nil {
+				return nil, err
+			}
+			batch[i].Value = value
+		}
+		return json.Marshal(batch)
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(content, &object); err != nil || object == nil {
+		return content, nil
+	}
+	for _, field := range billingCacheOmittedFields {
+		delete(object, field)
+	}
+	return json.Marshal(object)
+}
+
+// set stores the response of the API path without billingCacheOmittedFields, the file is written
+// atomically as the cache is shared by the queries. Errors are logged, the cache is only an optimization.
+func (c *billingCache) set(ctx context.Context, path string, content json.RawMessage) {
+	if c == nil {
+		return
+	}
+	filename, ok := c.filename(path)
+	if !ok {
+		return
+	}
+	content, err := omitBillingFields(content)
+	if err != nil {
+		plugin.Logger(ctx).Warn("ovh.billingCache", "path", path, "error", err)
+		return
+	}
+	if err := writeFileAtomic(filename, content); err != nil {
+		plugin.Logger(ctx).Warn("ovh.billingCache", "path", path, "error", err)
+	}
+}
+
+// getCached gets the API path from the billing cache, or from the API when it is not cached.
+func getCached[T any](ctx context.Context, client *ovh.Client, cache *billingCache, path string) (T, error) {
+	var result T
+	content := cache.get(path)
+	if content == nil {
+		if err := client.GetWithContext(ctx, path, &content); err != nil {
+			return result, err
+		}
+		cache.set(ctx, path, content)
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		return result, fmt.Errorf("invalid response of %s: %w", path, err)
+	}
+	return result, nil
+}
+
+// getBatchedCached is getBatched for the objects of the billing cache, the cached objects
+// are streamed first and only the other ones are fetched from the API.
+func getBatchedCached[T any](ctx context.Context, d *plugin.QueryData, client *ovh.Client, cache *billingCache, path string, ids []string, streamItem func(T)) error {
+	var missing []string
+	for _, id := range ids {
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+		content := cache.get(path + "/" + id)
+		if content == nil {
+			missing = append(missing, id)
+			continue
+		}
+		var item T
+		if err := json.Unmarshal(content, &item); err != nil {
+			missing = append(missing, id)
+			continue
+		}
+		streamItem(item)
+	}
+
+	return getBatchedByKey(ctx, d, client, path, missing, func(id string, content json.RawMessage) {
+		var item T
+		if err := json.Unmarshal(content, &item); err != nil {
+			plugin.Logger(ctx).Warn("ovh.getBatchedCached", "path", path, "key", id, "error", err)
+			return
+		}
+		cache.set(ctx, path+"/"+id, content)
+		streamItem(item)
+	})
+}
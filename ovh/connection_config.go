@@ -1,15 +1,35 @@
 package ovh
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
+	"gopkg.in/ini.v1"
 )
 
 type ovhConfig struct {
-	ApplicationKey    *string `cty:"application_key"`
-	ApplicationSecret *string `cty:"application_secret"`
-	ConsumerKey       *string `cty:"consumer_key"`
-	Endpoint          *string `cty:"endpoint"`
+	ApplicationKey    *string  `cty:"application_key"`
+	ApplicationSecret *string  `cty:"application_secret"`
+	ConsumerKey       *string  `cty:"consumer_key"`
+	ClientId          *string  `cty:"client_id"`
+	ClientSecret      *string  `cty:"client_secret"`
+	Endpoint          *string  `cty:"endpoint"`
+	BatchSize         *int     `cty:"batch_size"`
+	BatchConcurrency  *int     `cty:"batch_concurrency"`
+	RecordDir         *string  `cty:"record_dir"`
+	ReplayDir         *string  `cty:"replay_dir"`
+	BillingCacheDir   *string  `cty:"billing_cache_dir"`
+	Projects          []string `cty:"projects"`
+	Regions           []string `cty:"regions"`
+	ApiSchemaFiles    []string `cty:"api_schema_files" steampipe:"watch"`
+	DynamicTables     []string `cty:"dynamic_tables"`
+	TagColumns        []string `cty:"tag_columns"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -22,9 +42,50 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"consumer_key": {
 		Type: schema.TypeString,
 	},
+	"client_id": {
+		Type: schema.TypeString,
+	},
+	"client_secret": {
+		Type: schema.TypeString,
+	},
 	"endpoint": {
 		Type: schema.TypeString,
 	},
+	"batch_size": {
+		Type: schema.TypeInt,
+	},
+	"batch_concurrency": {
+		Type: schema.TypeInt,
+	},
+	"record_dir": {
+		Type: schema.TypeString,
+	},
+	"replay_dir": {
+		Type: schema.TypeString,
+	},
+	"billing_cache_dir": {
+		Type: schema.TypeString,
+	},
+	"projects": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"regions": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"api_schema_files": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"dynamic_tables": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"tag_columns": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 }
 
 func ConfigInstance() interface{} {
@@ -39,3 +100,179 @@ func GetConfig(connection *plugin.Connection) ovhConfig {
 	config, _ := connection.Config.(ovhConfig)
 	return config
 }
+
+// matchFilters :: return whether the value matches the filters, a list of wildcard
+// patterns where a pattern starting with ! excludes the matching values.
+// The value must match an include pattern, if any, and no exclude pattern.
+func matchFilters(filters []string, value string) bool {
+	included := true
+	for _, filter := range filters {
+		if !strings.HasPrefix(filter, "!") {
+			included = false
+			break
+		}
+	}
+	for _, filter := range filters {
+		if pattern, exclude := strings.CutPrefix(filter, "!"); exclude {
+			if matched, _ := path.Match(pattern, value); matched {
+				return false
+			}
+		} else if matched, _ := path.Match(pattern, value); matched {
+			included = true
+		}
+	}
+	return included
+}
+
+// validateFilters :: return an error for the first invalid pattern of the filters
+func validateFilters(name string, filters []string) error {
+	for _, filter := range filters {
+		if _, err := path.Match(strings.TrimPrefix(filter, "!"), ""); err != nil {
+			return fmt.Errorf("invalid pattern %q in '%s'. Edit your connection configuration file and then restart Steampipe", filter, name)
+		}
+	}
+	return nil
+}
+
+// projectIncluded :: return whether the project matches the projects filters of the connection
+func projectIncluded(d *plugin.QueryData, projectId string) bool {
+	return matchFilters(GetConfig(d.Connection).Projects, projectId)
+}
+
+// regionIncluded :: return whether the region matches the regions filters of the connection
+func regionIncluded(d *plugin.QueryData, region string) bool {
+	return matchFilters(GetConfig(d.Connection).Regions, region)
+}
+
+// ovhConfPaths are the ovh.conf files shared with go-ovh and the other OVH API
+// wrappers, by order of increasing priority
+var ovhConfPaths = []string{
+	"/etc/ovh.conf",
+	"~/.ovh.conf",
+	"./ovh.conf",
+}
+
+// loadOvhConf :: load the ovh.conf files, missing files are ignored
+func loadOvhConf() (*ini.File, error) {
+	var paths []interface{}
+	for _, path := range ovhConfPaths {
+		if strings.HasPrefix(path, "~/") {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				continue
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		paths = append(paths, path)
+	}
+	cfg, err := ini.LooseLoad(paths[0], paths[1:]...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load ovh.conf: %w", err)
+	}
+	return cfg, nil
+}
+
+// applicationKeySettings and oauth2Settings are the settings of the two authentication styles
+var (
+	applicationKeySettings = []string{"application_key", "application_secret", "consumer_key"}
+	oauth2Settings         = []string{"client_id", "client_secret"}
+)
+
+// getAuthSettings :: return the credentials of the authentication style of the first source
+// setting any of them: the connection config, the OVH_<NAME> environment variables, then the
+// section of the ovh.conf files. The next sources only fill the settings of this style left
+// empty. go-ovh reads the environment and the ovh.conf files again when creating the client
+// and refuses several authentication methods, so a credential of another method set in the
+// environment or the ovh.conf files is an error naming it.
+func getAuthSettings(config map[string]*string, cfg *ini.File, section string) (settings map[string]string, useOAuth2 bool, err error) {
+	sources := []func(name string) string{
+		func(name string) string {
+			if value := config[name]; value != nil {
+				return *value
+			}
+			return ""
+		},
+		func(name string) string {
+			return os.Getenv("OVH_" + strings.ToUpper(name))
+		},
+		func(name string) string {
+			if cfg == nil || section == "" || !cfg.HasSection(section) {
+				return ""
+			}
+			return cfg.Section(section).Key(name).String()
+		},
+	}
+	anySet := func(source func(string) string, names []string) bool {
+		for _, name := range names {
+			if source(name) != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	var names []string
+	for _, source := range sources {
+		useApplicationKey := anySet(source, applicationKeySettings)
+		useOAuth2 = anySet(source, oauth2Settings)
+		if useApplicationKey && useOAuth2 {
+			return nil, false, errors.New("'application_key', 'application_secret' and 'consumer_key' cannot be used together with 'client_id' and 'client_secret', choose one authentication method. Edit your connection configuration file and then restart Steampipe")
+		}
+		if useApplicationKey {
+			names = applicationKeySettings
+			break
+		}
+		if useOAuth2 {
+			names = oauth2Settings
+			break
+		}
+	}
+	if names == nil {
+		return nil, false, errors.New("either 'application_key', 'application_secret' and 'consumer_key' or 'client_id' and 'client_secret' must be set in the connection configuration, the OVH_* environment variables or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
+	}
+
+	otherNames := []string{"access_token"}
+	if useOAuth2 {
+		otherNames = append(otherNames, applicationKeySettings...)
+	} else {
+		otherNames = append(otherNames, oauth2Settings...)
+	}
+	for _, name := range otherNames {
+		origin := ""
+		switch {
+		case sources[1](name) != "":
+			origin = fmt.Sprintf("the OVH_%s environment variable", strings.ToUpper(name))
+		case sources[2](name) != "":
+			origin = fmt.Sprintf("'%s' in the [%s] section of the ovh.conf files", name, section)
+		default:
+			continue
+		}
+		return nil, false, fmt.Errorf("%s cannot be used together with '%s', only one authentication method can be used: unset or remove it. Edit your connection configuration file and then restart Steampipe", origin, strings.Join(names, "', '"))
+	}
+
+	settings = map[string]string{}
+	for _, name := range names {
+		for _, source := range sources {
+			if value := source(name); value != "" {
+				settings[name] = value
+				break
+			}
+		}
+	}
+	return settings, useOAuth2, nil
+}
+
+// getConfigValue :: return the connection config value if set, then the OVH_<NAME>
+// environment variable, then the name key of the section in the ovh.conf files
+func getConfigValue(value *string, cfg *ini.File, section, name string) string {
+	if value != nil && *value != "" {
+		return *value
+	}
+	if fromEnv := os.Getenv("OVH_" + strings.ToUpper(name)); fromEnv != "" {
+		return fromEnv
+	}
+	if cfg == nil || section == "" || !cfg.HasSection(section) {
+		return ""
+	}
+	return cfg.Section(section).Key(name).String()
+}
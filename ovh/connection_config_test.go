@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatchFilters(t *testing.T) {
+	tests := []struct {
+		filters []string
+		value   string
+		matched bool
+	}{
+		{nil, "GRA11", true},
+		{[]string{"GRA*"}, "GRA11", true},
+		{[]string{"GRA*"}, "SBG5", false},
+		{[]string{"GRA*", "SBG*"}, "SBG5", true},
+		{[]string{"!SBG*"}, "GRA11", true},
+		{[]string{"!SBG*"}, "SBG5", false},
+		{[]string{"*", "!SBG5"}, "SBG5", false},
+		{[]string{"!SBG5", "*"}, "SBG5", false},
+		{[]string{"GRA?"}, "GRA11", false},
+		{[]string{"[invalid"}, "GRA11", false},
+	}
+	for _, test := range tests {
+		if matched := matchFilters(test.filters, test.value); matched != test.matched {
+			t.Errorf("matchFilters(%q, %q) = %t, expected %t", test.filters, test.value, matched, test.matched)
+		}
+	}
+}
+
+func TestValidateFilters(t *testing.T) {
+	if err := validateFilters("regions", []string{"GRA*", "!SBG?"}); err != nil {
+		t.Errorf("expected valid filters, got %s", err)
+	}
+	if err := validateFilters("regions", []string{"!GRA[1"}); err == nil {
+		t.Error("expected an invalid pattern error")
+	}
+}
+
+func TestGetAuthSettings(t *testing.T) {
+	applicationKey := "ak"
+	for _, name := range []string{"OVH_APPLICATION_KEY", "OVH_CLIENT_ID", "OVH_CLIENT_SECRET", "OVH_ACCESS_TOKEN"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("OVH_APPLICATION_SECRET", "env-secret")
+	t.Setenv("OVH_CONSUMER_KEY", "")
+
+	settings, useOAuth2, err := getAuthSettings(map[string]*string{"application_key": &applicationKey}, nil, "")
+	if err != nil {
+		t.Fatalf("expected the application key of the connection config, got %s", err)
+	}
+	if useOAuth2 {
+		t.Error("expected the application key authentication")
+	}
+	if settings["application_key"] != "ak" || settings["application_secret"] != "env-secret" || settings["client_id"] != "" {
+		t.Errorf("unexpected settings %v", settings)
+	}
+
+	t.Setenv("OVH_APPLICATION_SECRET", "")
+	t.Setenv("OVH_CLIENT_ID", "env-client-id")
+	settings, useOAuth2, err = getAuthSettings(map[string]*string{}, nil, "")
+	if err != nil {
+		t.Fatalf("expected the OAuth2 client of the environment, got %s", err)
+	}
+	if !useOAuth2 || settings["client_id"] != "env-client-id" {
+		t.Errorf("unexpected settings %v", settings)
+	}
+
+	// go-ovh would read the OAuth2 client ID of the environment too
+	_, _, err = getAuthSettings(map[string]*string{"application_key": &applicationKey}, nil, "")
+	if err == nil || !strings.Contains(err.Error(), "OVH_CLIENT_ID") {
+		t.Errorf("expected an error naming OVH_CLIENT_ID, got %v", err)
+	}
+
+	clientSecret := "cs"
+	if _, _, err := getAuthSettings(map[string]*string{"application_key": &applicationKey, "client_secret": &clientSecret}, nil, ""); err == nil {
+		t.Error("expected an error for both authentication methods in the connection config")
+	}
+}
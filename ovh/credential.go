@@ -0,0 +1,106 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// getCurrentCredential returns the credential of the consumer key of the connection.
+func getCurrentCredential(ctx context.Context, client *ovh.Client) (*Credential, error) {
+	var credential Credential
+	err := client.GetWithContext(ctx, "/auth/currentCredential", &credential)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the consumer key: %w", err)
+	}
+	return &credential, nil
+}
+
+// validateCredential checks the consumer key of the connection, it is called once
+// when the connection is first used so that an unusable key fails with a clear error.
+func validateCredential(credential *Credential) error {
+	switch {
+	case credential.Status == "pendingValidation":
+		return fmt.Errorf("the consumer key %d is pending validation, open the validation URL returned when it was created or create a new consumer key. Edit your connection configuration file and then restart Steampipe", credential.CredentialID)
+	case credential.Status == "expired" || (credential.Expiration != nil && credential.Expiration.Before(time.Now())):
+		return fmt.Errorf("the consumer key %d has expired, create a new consumer key. Edit your connection configuration file and then restart Steampipe", credential.CredentialID)
+	case credential.Status != "validated":
+		return fmt.Errorf("the consumer key %d is %s, create a new consumer key. Edit your connection configuration file and then restart Steampipe", credential.CredentialID, credential.Status)
+	}
+	return nil
+}
+
+// accessRulesTransport explains the requests denied (403) by the access rules of the
+// consumer key, the method and path missing from the rules are added to the API error message.
+type accessRulesTransport struct {
+	rules []ovh.AccessRule
+	// basePath is the path of the endpoint, the rules are relative to it
+	basePath string
+	next     http.RoundTripper
+}
+
+func (t *accessRulesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+	path := strings.TrimPrefix(req.URL.Path, t.basePath)
+	if allowedByRules(t.rules, req.Method, path) {
+		return resp, nil
+	}
+
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var apiError map[string]interface{}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return resp, nil
+	}
+	apiError["message"] = fmt.Sprintf("%v: %s %s is not allowed by the access rules of the consumer key (%s)",
+		apiError["message"], req.Method, path, formatRules(t.rules))
+	body, err = json.Marshal(apiError)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// allowedByRules returns whether a rule allows the request, * matches any characters of the path.
+func allowedByRules(rules []ovh.AccessRule, method, path string) bool {
+	for _, rule := range rules {
+		if rule.Method != method {
+			continue
+		}
+		parts := strings.Split(rule.Path, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		if matched, _ := regexp.MatchString("^"+strings.Join(parts, ".*")+"$", path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func formatRules(rules []ovh.AccessRule) string {
+	if len(rules) == 0 {
+		return "no rule"
+	}
+	formatted := make([]string, len(rules))
+	for i, rule := range rules {
+		formatted[i] = rule.Method + " " + rule.Path
+	}
+	return strings.Join(formatted, ", ")
+}
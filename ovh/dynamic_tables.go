@@ -0,0 +1,517 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// apiSchema is an OVH API description, the /1.0/<product>.json documents
+type apiSchema struct {
+	Apis   []apiSchemaRoute          `json:"apis"`
+	Models map[string]apiSchemaModel `json:"models"`
+}
+
+type apiSchemaRoute struct {
+	Path       string               `json:"path"`
+	Operations []apiSchemaOperation `json:"operations"`
+}
+
+type apiSchemaOperation struct {
+	HttpMethod string `json:"httpMethod"`
+	ApiStatus  struct {
+		Value string `json:"value"`
+	} `json:"apiStatus"`
+	ResponseType string               `json:"responseType"`
+	Parameters   []apiSchemaParameter `json:"parameters"`
+}
+
+type apiSchemaParameter struct {
+	Name        string `json:"name"`
+	DataType    string `json:"dataType"`
+	ParamType   string `json:"paramType"`
+	Description string `json:"description"`
+}
+
+type apiSchemaModel struct {
+	Description string                       `json:"description"`
+	Enum        []string                     `json:"enum"`
+	Properties  map[string]apiSchemaProperty `json:"properties"`
+}
+
+type apiSchemaProperty struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// dynamicTable is a table generated from a list route returning IDs, like /domain,
+// and the route of each object, like /domain/{serviceName}
+type dynamicTable struct {
+	name        string
+	description string
+	listPath    string
+	itemPath    string
+	// params are the path parameters of the item route, the last one is the ID of the object
+	params     []apiSchemaParameter
+	properties map[string]apiSchemaProperty
+	models     map[string]apiSchemaModel
+}
+
+// dynamicRow is a row of a dynamic table, keyed by column name
+type dynamicRow map[string]interface{}
+
+var pathParamRegexp = regexp.MustCompile(`\{([^}]+)\}`)
+
+// pluginTableMap returns the static tables of the plugin, with the columns of tag_columns,
+// and, when dynamic_tables is set, the tables generated from the API descriptions of api_schema_files.
+func pluginTableMap(ctx context.Context, d *plugin.TableMapData, staticTables map[string]*plugin.Table) (map[string]*plugin.Table, error) {
+	tables := make(map[string]*plugin.Table, len(staticTables))
+	for name, table := range staticTables {
+		tables[name] = table
+	}
+
+	config := GetConfig(d.Connection)
+	if err := addTagColumns(tables, config.TagColumns); err != nil {
+		return nil, err
+	}
+	if len(config.DynamicTables) == 0 {
+		return tables, nil
+	}
+	if len(config.ApiSchemaFiles) == 0 {
+		return nil, fmt.Errorf("'api_schema_files' must be set to use 'dynamic_tables'. Edit your connection configuration file and then restart Steampipe")
+	}
+	for _, prefix := range config.DynamicTables {
+		if !strings.HasPrefix(prefix, "/") {
+			return nil, fmt.Errorf("invalid API path %q in 'dynamic_tables', it must start with /. Edit your connection configuration file and then restart Steampipe", prefix)
+		}
+	}
+
+	var schemas []apiSchema
+	for _, source := range config.ApiSchemaFiles {
+		filenames, err := d.GetSourceFiles(source)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get the API schema files %s: %w", source, err)
+		}
+		for _, filename := range filenames {
+			content, err := os.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			var schema apiSchema
+			if err := json.Unmarshal(content, &schema); err != nil {
+				return nil, fmt.Errorf("invalid API schema file %s: %w", filename, err)
+			}
+			if len(schema.Apis) == 0 {
+				return nil, fmt.Errorf("invalid API schema file %s: no API route", filename)
+			}
+			schemas = append(schemas, schema)
+		}
+	}
+
+	for _, dt := range dynamicTables(schemas, config.DynamicTables) {
+		if _, exists := tables[dt.name]; exists {
+			plugin.Logger(ctx).Warn("ovh.pluginTableMap", "table", dt.name, "path", dt.listPath, "message", "a table with the same name already exists")
+			continue
+		}
+		tables[dt.name] = dt.table()
+	}
+	return tables, nil
+}
+
+// dynamicTables finds the list and item routes under the prefixes in the API descriptions.
+func dynamicTables(schemas []apiSchema, prefixes []string) []dynamicTable {
+	getOperations := map[string]apiSchemaOperation{}
+	models := map[string]apiSchemaModel{}
+	for _, schema := range schemas {
+		for name, model := range schema.Models {
+			models[name] = model
+		}
+		for _, route := range schema.Apis {
+			for _, operation := range route.Operations {
+				if operation.HttpMethod == "GET" && operation.ApiStatus.Value != "DELETED" {
+					getOperations[route.Path] = operation
+				}
+			}
+		}
+	}
+
+	var tables []dynamicTable
+	for listPath, listOperation := range getOperations {
+		if !matchPrefixes(prefixes, listPath) || !isIdList(listOperation.ResponseType, models) {
+			continue
+		}
+		for itemPath, itemOperation := range getOperations {
+			if !isItemPath(listPath, itemPath) {
+				continue
+			}
+			model, ok := models[itemOperation.ResponseType]
+			if !ok || len(model.Properties) == 0 {
+				continue
+			}
+			description := model.Description
+			if description == "" {
+				description = fmt.Sprintf("Objects of the %s API route.", listPath)
+			}
+			tables = append(tables, dynamicTable{
+				name:        dynamicTableName(listPath),
+				description: description,
+				listPath:    listPath,
+				itemPath:    itemPath,
+				params:      pathParams(itemPath, itemOperation.Parameters),
+				properties:  model.Properties,
+				models:      models,
+			})
+		}
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].listPath < tables[j].listPath
+	})
+	return tables
+}
+
+func matchPrefixes(prefixes []string, path string) bool {
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdList returns whether the type is an array of IDs (strings, numbers or enums).
+func isIdList(responseType string, models map[string]apiSchemaModel) bool {
+	element, ok := strings.CutSuffix(responseType, "[]")
+	if !ok {
+		return false
+	}
+	switch apiSchemaColumnType(element, models) {
+	case proto.ColumnType_STRING, proto.ColumnType_INT:
+		return true
+	}
+	return false
+}
+
+// isItemPath returns whether the path is the list path followed by a single path parameter.
+func isItemPath(listPath, path string) bool {
+	param, ok := strings.CutPrefix(path, listPath+"/")
+	return ok && strings.HasPrefix(param, "{") && strings.HasSuffix(param, "}") && !strings.Contains(param, "/")
+}
+
+// pathParams returns the path parameters of the route, in order of appearance.
+func pathParams(path string, parameters []apiSchemaParameter) []apiSchemaParameter {
+	var params []apiSchemaParameter
+	for _, match := range pathParamRegexp.FindAllStringSubmatch(path, -1) {
+		param := apiSchemaParameter{Name: match[1], DataType: "string", ParamType: "path"}
+		for _, parameter := range parameters {
+			if parameter.ParamType == "path" && parameter.Name == match[1] {
+				param = parameter
+			}
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+var camelCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+var nonAlphanumericRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// snakeCase converts an API name like dynHost or serviceName to a column name.
+func snakeCase(name string) string {
+	name = strings.ToLower(camelCaseRegexp.ReplaceAllString(name, "${1}_${2}"))
+	return strings.Trim(nonAlphanumericRegexp.ReplaceAllString(name, "_"), "_")
+}
+
+// dynamicTableName is ovh_ followed by the segments of the path without the parameters,
+// /domain/zone/{zoneName}/dynHost/record is ovh_domain_zone_dyn_host_record.
+func dynamicTableName(path string) string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" && !strings.HasPrefix(segment, "{") {
+			segments = append(segments, snakeCase(segment))
+		}
+	}
+	return "ovh_" + strings.Join(segments, "_")
+}
+
+// apiSchemaColumnType maps the type of a property or a parameter to a column type.
+func apiSchemaColumnType(typeName string, models map[string]apiSchemaModel) proto.ColumnType {
+	if strings.HasSuffix(typeName, "[]") || strings.HasPrefix(typeName, "map[") {
+		return proto.ColumnType_JSON
+	}
+	switch typeName {
+	case "boolean":
+		return proto.ColumnType_BOOL
+	case "long", "int":
+		return proto.ColumnType_INT
+	case "double", "float":
+		return proto.ColumnType_DOUBLE
+	case "datetime", "date":
+		return proto.ColumnType_TIMESTAMP
+	case "ip", "ipv4", "ipv6", "ipInterface", "ipv4Interface", "ipv6Interface":
+		return proto.ColumnType_INET
+	case "ipBlock", "ipv4Block", "ipv6Block":
+		return proto.ColumnType_CIDR
+	case "string", "password", "text", "time", "uuid", "duration", "phoneNumber", "internationalPhoneNumber":
+		return proto.ColumnType_STRING
+	}
+	if model, ok := models[typeName]; ok && len(model.Enum) > 0 {
+		return proto.ColumnType_STRING
+	}
+	return proto.ColumnType_JSON
+}
+
+// columns are the path parameters then the properties of the model, by name, and the
+// standard columns, the title is the ID of the object.
+func (dt dynamicTable) columns() []*plugin.Column {
+	var columns []*plugin.Column
+	names := map[string]bool{}
+	for _, param := range dt.params {
+		columnType := proto.ColumnType_STRING
+		if apiSchemaColumnType(param.DataType, dt.models) == proto.ColumnType_INT {
+			columnType = proto.ColumnType_INT
+		}
+		description := param.Description
+		if description == "" {
+			description = fmt.Sprintf("The %s path parameter.", param.Name)
+		}
+		columns = append(columns, &plugin.Column{
+			Name:        snakeCase(param.Name),
+			Type:        columnType,
+			Description: description,
+		})
+		names[snakeCase(param.Name)] = true
+	}
+
+	properties := make([]string, 0, len(dt.properties))
+	for name := range dt.properties {
+		properties = append(properties, name)
+	}
+	sort.Strings(properties)
+	for _, name := range properties {
+		if names[snakeCase(name)] {
+			continue
+		}
+		columns = append(columns, &plugin.Column{
+			Name:        snakeCase(name),
+			Type:        apiSchemaColumnType(dt.properties[name].Type, dt.models),
+			Description: dt.properties[name].Description,
+		})
+		names[snakeCase(name)] = true
+	}
+
+	// the IAM type of the objects is unknown, they have no akas
+	title := &plugin.Column{
+		Name:        "title",
+		Type:        proto.ColumnType_STRING,
+		Transform:   transform.FromP(dynamicColumnValue, snakeCase(dt.params[len(dt.params)-1].Name)),
+		Description: "Title of the resource.",
+	}
+	for _, column := range append([]*plugin.Column{title, nullTagsColumn()}, commonColumns(nil)...) {
+		if !names[column.Name] && column.Name != "akas" {
+			columns = append(columns, column)
+		}
+	}
+	for _, column := range columns {
+		if column.Transform == nil {
+			column.Transform = transform.From(dynamicColumnValue)
+		}
+	}
+	return columns
+}
+
+func (dt dynamicTable) table() *plugin.Table {
+	columns := dt.columns()
+	var listKeyColumns []string
+	var getKeyColumns []string
+	for i, param := range dt.params {
+		if i < len(dt.params)-1 {
+			listKeyColumns = append(listKeyColumns, snakeCase(param.Name))
+		}
+		getKeyColumns = append(getKeyColumns, snakeCase(param.Name))
+	}
+	columnTypes := map[string]proto.ColumnType{}
+	for _, column := range columns {
+		columnTypes[column.Name] = column.Type
+	}
+
+	return &plugin.Table{
+		Name:        dt.name,
+		Description: dt.description,
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.AllColumns(listKeyColumns),
+			Hydrate: func(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+				return dt.list(ctx, d, columnTypes)
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns(getKeyColumns),
+			Hydrate: func(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+				return dt.get(ctx, d, columnTypes)
+			},
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: columns,
+	}
+}
+
+// path replaces the parameters of the route with the values of the row.
+func (dt dynamicTable) path(route string, row dynamicRow) string {
+	return pathParamRegexp.ReplaceAllStringFunc(route, func(param string) string {
+		return url.PathEscape(fmt.Sprint(row[snakeCase(param[1:len(param)-1])]))
+	})
+}
+
+// keyRow returns a row with the values of the path parameters set in the quals.
+func (dt dynamicTable) keyRow(d *plugin.QueryData, columnTypes map[string]proto.ColumnType) dynamicRow {
+	row := dynamicRow{}
+	for _, param := range dt.params {
+		column := snakeCase(param.Name)
+		if qual, ok := d.EqualsQuals[column]; ok {
+			if columnTypes[column] == proto.ColumnType_INT {
+				row[column] = qual.GetInt64Value()
+			} else {
+				row[column] = qual.GetStringValue()
+			}
+		}
+	}
+	return row
+}
+
+func (dt dynamicTable) list(ctx context.Context, d *plugin.QueryData, columnTypes map[string]proto.ColumnType) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(dt.name+".list", "connection_error", err)
+		return nil, err
+	}
+
+	parent := dt.keyRow(d, columnTypes)
+	var rawIds []json.RawMessage
+	err = client.GetWithContext(ctx, dt.path(dt.listPath, parent), &rawIds)
+	if err != nil {
+		plugin.Logger(ctx).Error(dt.name+".list", err)
+		return nil, err
+	}
+
+	idColumn := snakeCase(dt.params[len(dt.params)-1].Name)
+	ids := make([]string, len(rawIds))
+	idValues := map[string]interface{}{}
+	for i, rawId := range rawIds {
+		id := decodeDynamicValue(rawId, columnTypes[idColumn])
+		ids[i] = url.PathEscape(fmt.Sprint(id))
+		idValues[fmt.Sprint(id)] = id
+	}
+
+	err = getBatchedByKey(ctx, d, client, dt.path(dt.listPath, parent), ids, func(key string, item json.RawMessage) {
+		row := dt.row(parent, item, columnTypes)
+		if unescaped, err := url.PathUnescape(key); err == nil {
+			key = unescaped
+		}
+		if id, ok := idValues[key]; ok {
+			row[idColumn] = id
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error(dt.name+".list", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (dt dynamicTable) get(ctx context.Context, d *plugin.QueryData, columnTypes map[string]proto.ColumnType) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(dt.name+".get", "connection_error", err)
+		return nil, err
+	}
+
+	key := dt.keyRow(d, columnTypes)
+	var item json.RawMessage
+	err = client.GetWithContext(ctx, dt.path(dt.itemPath, key), &item)
+	if err != nil {
+		plugin.Logger(ctx).Error(dt.name+".get", err)
+		return nil, err
+	}
+	return dt.row(key, item, columnTypes), nil
+}
+
+// row builds the row of an object, the path parameters are those of the key.
+func (dt dynamicTable) row(key dynamicRow, item json.RawMessage, columnTypes map[string]proto.ColumnType) dynamicRow {
+	row := dynamicRow{}
+	for column, value := range key {
+		row[column] = value
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(item, &object); err != nil {
+		return row
+	}
+	for name, value := range object {
+		column := snakeCase(name)
+		if _, ok := columnTypes[column]; ok {
+			row[column] = decodeDynamicValue(value, columnTypes[column])
+		}
+	}
+	return row
+}
+
+// decodeDynamicValue decodes a JSON value for a column of the given type,
+// the numbers are decoded as int64 or float64 depending on the column.
+func decodeDynamicValue(raw json.RawMessage, columnType proto.ColumnType) interface{} {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || value == nil {
+		return nil
+	}
+	if columnType == proto.ColumnType_JSON {
+		// strings are passed as raw JSON to the JSON columns
+		return raw
+	}
+	if number, ok := value.(json.Number); ok {
+		switch columnType {
+		case proto.ColumnType_INT:
+			if i, err := number.Int64(); err == nil {
+				return i
+			}
+		case proto.ColumnType_DOUBLE:
+			if f, err := number.Float64(); err == nil {
+				return f
+			}
+		}
+		return number.String()
+	}
+	if columnType == proto.ColumnType_INT {
+		if s, ok := value.(string); ok {
+			if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return i
+			}
+		}
+	}
+	return value
+}
+
+// dynamicColumnValue returns the value of the column in a dynamicRow, or of the column
+// given as parameter.
+func dynamicColumnValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row, ok := d.HydrateItem.(dynamicRow)
+	if !ok {
+		return nil, nil
+	}
+	if column, ok := d.Param.(string); ok {
+		return row[column], nil
+	}
+	return row[d.ColumnName], nil
+}
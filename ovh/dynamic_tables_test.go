@@ -0,0 +1,147 @@
+package ovh
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+var dynamicTablesConfig = []string{
+	`api_schema_files = ["testdata/api_schema/*.json"]`,
+	`dynamic_tables = ["/domain", "/dedicated/server"]`,
+}
+
+func TestDynamicTablesSchema(t *testing.T) {
+	_, p := newTestPlugin(t, nil, dynamicTablesConfig...)
+
+	expected := map[string][]string{
+		"ovh_domain":                      {"service_name", "domain", "glue_record_ipv6_supported", "last_update", "name_server_type", "offer", "parent_service", "whois_owner", "title", "tags", "account", "sp_connection_name", "sp_ctx", "_ctx"},
+		"ovh_domain_zone_record":          {"zone_name", "id", "field_type", "sub_domain", "target", "ttl", "zone", "title", "tags", "account", "sp_connection_name", "sp_ctx", "_ctx"},
+		"ovh_domain_zone_dyn_host_record": {"zone_name", "id", "ip", "sub_domain", "zone", "title", "tags", "account", "sp_connection_name", "sp_ctx", "_ctx"},
+	}
+	for table, columns := range expected {
+		if actual := p.Columns(table); !reflect.DeepEqual(actual, columns) {
+			t.Errorf("table %s: expected the columns %v, got %v", table, columns, actual)
+		}
+	}
+	// the deleted routes are ignored and the static tables are kept
+	if columns := p.Columns("ovh_domain_glue_record"); columns != nil {
+		t.Errorf("expected no table for a deleted route, got %v", columns)
+	}
+	if columns := p.Columns("ovh_dedicated_server"); !strings.Contains(strings.Join(columns, ","), "power_state") {
+		t.Errorf("expected the static ovh_dedicated_server table, got %v", columns)
+	}
+}
+
+func TestDynamicTablesDisabled(t *testing.T) {
+	_, p := newTestPlugin(t, nil, dynamicTablesConfig[0])
+
+	if columns := p.Columns("ovh_domain"); columns != nil {
+		t.Errorf("expected no dynamic table without dynamic_tables, got %v", columns)
+	}
+}
+
+func TestDynamicTableList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_domain"}, dynamicTablesConfig...)
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_domain"})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"service_name":               "example.com",
+		"domain":                     "example.com",
+		"glue_record_ipv6_supported": true,
+		"last_update":                mustParseTime(t, "2024-03-01T09:00:00Z"),
+		"name_server_type":           "hosted",
+		"offer":                      "gold",
+		"parent_service":             nil,
+		"whois_owner":                "12345",
+		"title":                      "example.com",
+		"tags":                       nil,
+		"account":                    testNichandle,
+	})
+}
+
+func TestDynamicTableListWithParent(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_domain"}, dynamicTablesConfig...)
+
+	rows := sortRows(execute(t, p, ovhtest.Query{
+		Table: "ovh_domain_zone_record",
+		Quals: []ovhtest.Qual{ovhtest.Equals("zone_name", "example.com")},
+	}), "field_type")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"zone_name":  "example.com",
+		"id":         int64(1001),
+		"field_type": "A",
+		"sub_domain": "www",
+		"target":     "192.0.2.10",
+		"ttl":        int64(3600),
+	})
+	checkRow(t, rows[1], ovhtest.Row{
+		"id":         int64(1002),
+		"field_type": "MX",
+		"ttl":        int64(0),
+	})
+}
+
+func TestDynamicTableGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_domain"}, dynamicTablesConfig...)
+
+	rows := execute(t, p, ovhtest.Query{
+		Table: "ovh_domain_zone_dyn_host_record",
+		Quals: []ovhtest.Qual{ovhtest.Equals("zone_name", "example.com"), ovhtest.Equals("id", int64(7))},
+	})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"zone_name":  "example.com",
+		"id":         int64(7),
+		"ip":         "192.0.2.20",
+		"sub_domain": "home",
+		"title":      "7",
+	})
+}
+
+func TestDynamicTablesConfigErrors(t *testing.T) {
+	tests := []struct {
+		config   ovhConfig
+		expected string
+	}{
+		{ovhConfig{DynamicTables: []string{"/domain"}}, "'api_schema_files' must be set"},
+		{ovhConfig{DynamicTables: []string{"domain"}, ApiSchemaFiles: []string{"testdata/api_schema/*.json"}}, `invalid API path "domain"`},
+		{ovhConfig{DynamicTables: []string{"/domain"}, ApiSchemaFiles: []string{"testdata/ovh_domain.json"}}, "invalid API schema file"},
+	}
+	for _, test := range tests {
+		d := &plugin.TableMapData{Connection: &plugin.Connection{Config: test.config}}
+
+		_, err := pluginTableMap(context.Background(), d, nil)
+
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("expected an error containing %q, got %v", test.expected, err)
+		}
+	}
+}
+
+func TestApiSchemaColumnNames(t *testing.T) {
+	tests := map[string]string{
+		"serviceName":             "service_name",
+		"glueRecordIpv6Supported": "glue_record_ipv6_supported",
+		"dynHost":                 "dyn_host",
+		"ipv4":                    "ipv4",
+		"SSHKey":                  "sshkey",
+	}
+	for name, expected := range tests {
+		if column := snakeCase(name); column != expected {
+			t.Errorf("snakeCase(%q) = %q, expected %q", name, column, expected)
+		}
+	}
+	if name := dynamicTableName("/domain/zone/{zoneName}/dynHost/record"); name != "ovh_domain_zone_dyn_host_record" {
+		t.Errorf("unexpected table name %s", name)
+	}
+}
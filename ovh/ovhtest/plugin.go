@@ -0,0 +1,251 @@
+package ovhtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/anywhere"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ConnectionName is the name of the connection queried by the Plugin.
+const ConnectionName = "ovh_test"
+
+// Config returns a connection config using the Server credentials, followed by the extra lines.
+func (s *Server) Config(extra ...string) string {
+	config := fmt.Sprintf("endpoint = %q\napplication_key = %q\napplication_secret = %q\nconsumer_key = %q\n",
+		s.Endpoint(), ApplicationKey, ApplicationSecret, ConsumerKey)
+	for _, line := range extra {
+		config += line + "\n"
+	}
+	return config
+}
+
+// Plugin runs a plugin in-process, as Steampipe does, with a single connection.
+type Plugin struct {
+	server *grpc.PluginServer
+	schema map[string]*proto.TableSchema
+}
+
+var (
+	serversMu sync.Mutex
+	// servers are the plugin servers by plugin func, a server is shared by the tests
+	// as the caches of a plugin allocate a lot of memory and are never released
+	servers = map[uintptr]*grpc.PluginServer{}
+)
+
+// NewPlugin starts the plugin, or reuses the plugin started by a previous test,
+// and sets the config of its connection.
+func NewPlugin(t testing.TB, pluginFunc plugin.PluginFunc, config string) *Plugin {
+	t.Helper()
+	serversMu.Lock()
+	defer serversMu.Unlock()
+
+	connectionConfig := &proto.ConnectionConfig{
+		Connection:      ConnectionName,
+		Plugin:          "ovh",
+		PluginShortName: "ovh",
+		Config:          config,
+	}
+	key := reflect.ValueOf(pluginFunc).Pointer()
+	server, ok := servers[key]
+	if !ok {
+		server = plugin.Server(&plugin.ServeOpts{PluginFunc: pluginFunc})
+		resp, err := server.SetAllConnectionConfigs(&proto.SetAllConnectionConfigsRequest{
+			Configs:        []*proto.ConnectionConfig{connectionConfig},
+			MaxCacheSizeMb: -1,
+		})
+		if err != nil {
+			t.Fatalf("cannot set the connection config: %s", err)
+		}
+		if failure, ok := resp.FailedConnections[ConnectionName]; ok {
+			t.Fatalf("cannot set the connection config: %s", failure)
+		}
+		// disable the query cache, each query calls the API
+		if _, err := server.SetCacheOptions(&proto.SetCacheOptionsRequest{Enabled: false, MaxSizeMb: 1}); err != nil {
+			t.Fatalf("cannot set the cache options: %s", err)
+		}
+		servers[key] = server
+	} else {
+		resp, err := server.UpdateConnectionConfigs(&proto.UpdateConnectionConfigsRequest{
+			Changed: []*proto.ConnectionConfig{connectionConfig},
+		})
+		if err != nil {
+			t.Fatalf("cannot update the connection config: %s", err)
+		}
+		if failure, ok := resp.FailedConnections[ConnectionName]; ok {
+			t.Fatalf("cannot update the connection config: %s", failure)
+		}
+		// the connection cache is only cleared when the config changes
+		_, err = server.SetConnectionCacheOptions(&proto.SetConnectionCacheOptionsRequest{ClearCacheForConnection: ConnectionName})
+		if err != nil {
+			t.Fatalf("cannot clear the connection cache: %s", err)
+		}
+	}
+	schema, err := server.GetSchema(&proto.GetSchemaRequest{Connection: ConnectionName})
+	if err != nil {
+		t.Fatalf("cannot get the schema: %s", err)
+	}
+	return &Plugin{server: server, schema: schema.Schema.Schema}
+}
+
+// Qual is a where clause of a Query.
+type Qual struct {
+	Column   string
+	Operator string
+	// Value is a string, int64, float64, bool or time.Time
+	Value interface{}
+}
+
+// Equals returns the qual column = value.
+func Equals(column string, value interface{}) Qual {
+	return Qual{Column: column, Operator: "=", Value: value}
+}
+
+// Query is a select on a table.
+type Query struct {
+	Table string
+	// Columns are the selected columns, every column of the table by default
+	Columns []string
+	Quals   []Qual
+	// Limit is the limit clause, no limit by default
+	Limit int64
+}
+
+// Row is a result row, with the JSON columns unmarshalled and the null columns set to nil.
+type Row map[string]interface{}
+
+// Columns returns the column names of a table.
+func (p *Plugin) Columns(table string) []string {
+	var columns []string
+	if schema, ok := p.schema[table]; ok {
+		for _, column := range schema.Columns {
+			columns = append(columns, column.Name)
+		}
+	}
+	return columns
+}
+
+// Execute runs a query and returns its rows.
+func (p *Plugin) Execute(ctx context.Context, query Query) ([]Row, error) {
+	if _, ok := p.schema[query.Table]; !ok {
+		return nil, fmt.Errorf("unknown table %s", query.Table)
+	}
+	columns := query.Columns
+	if columns == nil {
+		columns = p.Columns(query.Table)
+	}
+	quals := map[string]*proto.Quals{}
+	for _, qual := range query.Quals {
+		value, err := qualValue(qual.Value)
+		if err != nil {
+			return nil, err
+		}
+		if quals[qual.Column] == nil {
+			quals[qual.Column] = &proto.Quals{}
+		}
+		quals[qual.Column].Quals = append(quals[qual.Column].Quals, &proto.Qual{
+			FieldName: qual.Column,
+			Operator:  &proto.Qual_StringValue{StringValue: qual.Operator},
+			Value:     value,
+		})
+	}
+	var limit *proto.NullableInt
+	if query.Limit > 0 {
+		limit = &proto.NullableInt{Value: query.Limit}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := anywhere.NewLocalPluginStream(ctx)
+	p.server.CallExecuteAsync(&proto.ExecuteRequest{
+		Table: query.Table,
+		QueryContext: &proto.QueryContext{
+			Columns: columns,
+			Quals:   quals,
+			Limit:   limit,
+		},
+		Connection: ConnectionName,
+		CallId:     grpc.BuildCallId(),
+		ExecuteConnectionData: map[string]*proto.ExecuteConnectionData{
+			ConnectionName: {Limit: limit},
+		},
+	}, stream)
+
+	var rows []Row
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if response == nil {
+			return rows, nil
+		}
+		row, err := toRow(response.Row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+func qualValue(value interface{}) (*proto.QualValue, error) {
+	switch v := value.(type) {
+	case string:
+		return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: v}}, nil
+	case int:
+		return &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: int64(v)}}, nil
+	case int64:
+		return &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: v}}, nil
+	case float64:
+		return &proto.QualValue{Value: &proto.QualValue_DoubleValue{DoubleValue: v}}, nil
+	case bool:
+		return &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: v}}, nil
+	case time.Time:
+		return &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(v)}}, nil
+	case json.RawMessage:
+		return &proto.QualValue{Value: &proto.QualValue_JsonbValue{JsonbValue: string(v)}}, nil
+	}
+	return nil, fmt.Errorf("unsupported qual value %v (%T)", value, value)
+}
+
+func toRow(row *proto.Row) (Row, error) {
+	result := Row{}
+	for name, column := range row.GetColumns() {
+		switch value := column.GetValue().(type) {
+		case *proto.Column_DoubleValue:
+			result[name] = value.DoubleValue
+		case *proto.Column_IntValue:
+			result[name] = value.IntValue
+		case *proto.Column_StringValue:
+			result[name] = value.StringValue
+		case *proto.Column_BoolValue:
+			result[name] = value.BoolValue
+		case *proto.Column_JsonValue:
+			var v interface{}
+			if err := json.Unmarshal(value.JsonValue, &v); err != nil {
+				return nil, fmt.Errorf("invalid JSON value of column %s: %w", name, err)
+			}
+			result[name] = v
+		case *proto.Column_TimestampValue:
+			result[name] = value.TimestampValue.AsTime()
+		case *proto.Column_IpAddrValue:
+			result[name] = value.IpAddrValue
+		case *proto.Column_CidrRangeValue:
+			result[name] = value.CidrRangeValue
+		case *proto.Column_LtreeValue:
+			result[name] = value.LtreeValue
+		default:
+			result[name] = nil
+		}
+	}
+	return result, nil
+}
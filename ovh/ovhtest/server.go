@@ -0,0 +1,235 @@
+// Package ovhtest runs the plugin tables against an in-process imitation of the OVH API.
+package ovhtest
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Credentials accepted by the Server, requests signed with other credentials are rejected.
+const (
+	ApplicationKey    = "test-application-key"
+	ApplicationSecret = "test-application-secret"
+	ConsumerKey       = "test-consumer-key"
+)
+
+// Credential is the response of /auth/currentCredential, a validated consumer key allowed
+// to GET every path. Register another response to test the other credentials.
+var Credential = map[string]interface{}{
+	"credentialId":  1,
+	"applicationId": 1,
+	"creation":      "2024-01-01T00:00:00Z",
+	"expiration":    nil,
+	"lastUse":       nil,
+	"status":        "validated",
+	"ovhSupport":    false,
+	"allowedIPs":    nil,
+	"rules":         []map[string]string{{"method": "GET", "path": "/*"}},
+}
+
+// Server imitates the OVH API: it serves /auth/time and /auth/currentCredential, checks the application key,
+// consumer key and signature headers of each request, supports the X-Ovh-Batch mode
+// and answers with the registered responses.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []string
+}
+
+// NewServer starts a Server, closed at the end of the test.
+func NewServer(t testing.TB) *Server {
+	s := &Server{routes: map[string]http.HandlerFunc{}}
+	s.Handle(http.MethodGet, "/auth/currentCredential", http.StatusOK, Credential)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Endpoint is the endpoint to set in the connection config to query the Server.
+func (s *Server) Endpoint() string {
+	return s.URL + "/1.0"
+}
+
+// Handle registers the JSON response of a route. The path may contain a query string,
+// routes with a query string are matched before the routes without.
+func (s *Server) Handle(method, path string, status int, body interface{}) {
+	response, err := json.Marshal(body)
+	if err != nil {
+		panic(fmt.Sprintf("ovhtest: cannot marshal the response of %s %s: %s", method, path, err))
+	}
+	s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, status, json.RawMessage(response))
+	})
+}
+
+// HandleError registers an OVH API error response of a route.
+func (s *Server) HandleError(method, path string, status int, message string) {
+	s.Handle(method, path, status, apiError(status, message))
+}
+
+// HandleFunc registers the handler of a route.
+func (s *Server) HandleFunc(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" "+path] = handler
+}
+
+// LoadFixtures registers the routes of a JSON fixture file, an object
+// of "METHOD /path" keys and response bodies returned with a 200 status.
+func (s *Server) LoadFixtures(t testing.TB, filename string) {
+	t.Helper()
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("cannot read fixtures: %s", err)
+	}
+	var fixtures map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fixtures); err != nil {
+		t.Fatalf("cannot parse fixtures %s: %s", filename, err)
+	}
+	for route, body := range fixtures {
+		method, path, ok := strings.Cut(route, " ")
+		if !ok {
+			t.Fatalf("invalid route %q in fixtures %s", route, filename)
+		}
+		s.Handle(method, path, http.StatusOK, body)
+	}
+}
+
+// Requests returns the "METHOD /path?query" of the requests received by the Server,
+// without the /auth/time calls.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/1.0")
+	if r.Method == http.MethodGet && path == "/auth/time" {
+		writeJSON(w, http.StatusOK, time.Now().Unix())
+		return
+	}
+
+	route := r.Method + " " + path
+	if r.URL.RawQuery != "" {
+		route += "?" + r.URL.RawQuery
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, route)
+	s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if message := checkSignature(r, body); message != "" {
+		writeJSON(w, http.StatusForbidden, apiError(http.StatusForbidden, message))
+		return
+	}
+
+	if separator := r.Header.Get("X-Ovh-Batch"); separator != "" {
+		s.serveBatch(w, r, path, separator)
+		return
+	}
+	if handler := s.lookup(r.Method, path, r.URL.RawQuery); handler != nil {
+		handler(w, r)
+		return
+	}
+	writeJSON(w, http.StatusNotFound, apiError(http.StatusNotFound, fmt.Sprintf("The requested object (%s) does not exist", path)))
+}
+
+func (s *Server) lookup(method, path, query string) http.HandlerFunc {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if query != "" {
+		if handler, ok := s.routes[method+" "+path+"?"+query]; ok {
+			return handler
+		}
+	}
+	return s.routes[method+" "+path]
+}
+
+type batchResult struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+	Error string          `json:"error"`
+}
+
+// serveBatch answers a batch request by calling the route of each key of the last path segment.
+func (s *Server) serveBatch(w http.ResponseWriter, r *http.Request, path, separator string) {
+	slash := strings.LastIndex(path, "/")
+	results := []batchResult{}
+	for _, key := range strings.Split(path[slash+1:], separator) {
+		result := batchResult{Key: key, Value: json.RawMessage("null")}
+		handler := s.lookup(r.Method, path[:slash+1]+key, r.URL.RawQuery)
+		if handler == nil {
+			result.Error = fmt.Sprintf("The requested object (%s) does not exist", key)
+			results = append(results, result)
+			continue
+		}
+		recorder := httptest.NewRecorder()
+		handler(recorder, r)
+		if recorder.Code == http.StatusOK {
+			result.Value = recorder.Body.Bytes()
+		} else {
+			var apiError struct {
+				Message string `json:"message"`
+			}
+			_ = json.Unmarshal(recorder.Body.Bytes(), &apiError)
+			result.Error = apiError.Message
+		}
+		results = append(results, result)
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+// checkSignature checks the authentication headers as the OVH API does,
+// it returns the error message of an invalid request.
+func checkSignature(r *http.Request, body []byte) string {
+	if r.Header.Get("X-Ovh-Application") != ApplicationKey {
+		return "Invalid application key"
+	}
+	if r.Header.Get("X-Ovh-Consumer") != ConsumerKey {
+		return "Invalid credential"
+	}
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		return "Invalid timestamp"
+	}
+	if delta := time.Now().Unix() - timestamp; delta > 60 || delta < -60 {
+		return "Query out of time"
+	}
+	target := "http://" + r.Host + r.URL.RequestURI()
+	h := sha1.New()
+	fmt.Fprintf(h, "%s+%s+%s+%s+%s+%d", ApplicationSecret, ConsumerKey, r.Method, target, body, timestamp)
+	if r.Header.Get("X-Ovh-Signature") != fmt.Sprintf("$1$%x", h.Sum(nil)) {
+		return "Invalid signature"
+	}
+	return ""
+}
+
+func apiError(status int, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"class":    fmt.Sprintf("Client::%s", strings.ReplaceAll(http.StatusText(status), " ", "")),
+		"message":  message,
+		"httpCode": fmt.Sprintf("%d %s", status, http.StatusText(status)),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Ovh-QueryId", "EU.ext-1.test")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -15,33 +15,47 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			NewInstance: ConfigInstance,
 			Schema:      ConfigSchema,
 		},
+		SchemaMode: plugin.SchemaModeDynamic,
 		TableMap: map[string]*plugin.Table{
-			"ovh_bill":                    tableOvhBill(),
-			"ovh_bill_detail":             tableOvhBillDetails(),
-			"ovh_ceph":                    tableOvhCeph(),
-			"ovh_cloud_ai_app":            tableOvhCloudAIApp(),
-			"ovh_cloud_ai_job":            tableOvhCloudAIJob(),
-			"ovh_cloud_ai_notebook":       tableOvhCloudAINotebook(),
-			"ovh_cloud_data_job":          tableOvhCloudDataJob(),
-			"ovh_cloud_database":          tableOvhCloudDatabase(),
-			"ovh_cloud_flavor":            tableOvhCloudFlavor(),
-			"ovh_cloud_image":             tableOvhCloudImage(),
-			"ovh_cloud_instance":          tableOvhCloudInstance(),
-			"ovh_cloud_postgres":          tableOvhCloudPostgres(),
-			"ovh_cloud_project":           tableOvhCloudProject(),
-			"ovh_cloud_region":            tableOvhCloudRegion(),
-			"ovh_cloud_ssh_key":           tableOvhCloudSshKey(),
-			"ovh_cloud_storage_s3":        tableOvhCloudStorageS3(),
-			"ovh_cloud_storage_swift":     tableOvhCloudStorageSwift(),
-			"ovh_cloud_volume":            tableOvhCloudVolume(),
-			"ovh_cloud_volume_snapshot":   tableOvhCloudVolumeSnapshot(),
-			"ovh_dedicated_server":        tableOvhDedicatedServer(ctx),
-			"ovh_iam_resource":            tableOvhIamResource(),
-			"ovh_log_self":                tableOvhLog(),
-			"ovh_refund":                  tableOvhRefund(),
-			"ovh_refund_detail":           tableOvhRefundDetails(),
-			"ovh_savings_plan_subscribed": tableOvhSavingsPlanSubscribed(),
+			"ovh_api_get":                   tableOvhApiGet(),
+			"ovh_auth_current_credential":   tableOvhAuthCurrentCredential(),
+			"ovh_bill":                      tableOvhBill(),
+			"ovh_bill_detail":               tableOvhBillDetails(),
+			"ovh_ceph":                      tableOvhCeph(),
+			"ovh_cloud_ai_app":              tableOvhCloudAIApp(),
+			"ovh_cloud_ai_job":              tableOvhCloudAIJob(),
+			"ovh_cloud_ai_notebook":         tableOvhCloudAINotebook(),
+			"ovh_cloud_data_job":            tableOvhCloudDataJob(),
+			"ovh_cloud_database":            tableOvhCloudDatabase(),
+			"ovh_cloud_flavor":              tableOvhCloudFlavor(),
+			"ovh_cloud_image":               tableOvhCloudImage(),
+			"ovh_cloud_instance":            tableOvhCloudInstance(),
+			"ovh_cloud_kube":                tableOvhCloudKube(),
+			"ovh_cloud_kube_customization":  tableOvhCloudKubeCustomization(),
+			"ovh_cloud_kube_ip_restriction": tableOvhCloudKubeIpRestriction(),
+			"ovh_cloud_kube_node":           tableOvhCloudKubeNode(),
+			"ovh_cloud_kube_nodepool":       tableOvhCloudKubeNodepool(),
+			"ovh_cloud_network_private":     tableOvhCloudNetworkPrivate(),
+			"ovh_cloud_network_subnet":      tableOvhCloudNetworkSubnet(),
+			"ovh_cloud_postgres":            tableOvhCloudPostgres(),
+			"ovh_cloud_project":             tableOvhCloudProject(),
+			"ovh_cloud_region":              tableOvhCloudRegion(),
+			"ovh_cloud_ssh_key":             tableOvhCloudSshKey(),
+			"ovh_cloud_storage_s3":          tableOvhCloudStorageS3(),
+			"ovh_cloud_storage_swift":       tableOvhCloudStorageSwift(),
+			"ovh_cloud_volume":              tableOvhCloudVolume(),
+			"ovh_cloud_volume_snapshot":     tableOvhCloudVolumeSnapshot(),
+			"ovh_dedicated_server":          tableOvhDedicatedServer(ctx),
+			"ovh_iam_resource":              tableOvhIamResource(),
+			"ovh_log_self":                  tableOvhLog(),
+			"ovh_plugin_api_call":           tableOvhPluginApiCall(),
+			"ovh_refund":                    tableOvhRefund(),
+			"ovh_refund_detail":             tableOvhRefundDetails(),
+			"ovh_savings_plan_subscribed":   tableOvhSavingsPlanSubscribed(),
 		},
 	}
+	p.TableMapFunc = func(ctx context.Context, d *plugin.TableMapData) (map[string]*plugin.Table, error) {
+		return pluginTableMap(ctx, d, p.TableMap)
+	}
 	return p
 }
@@ -0,0 +1,401 @@
+package ovh
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+const testNichandle = "xx1234-ovh"
+
+// newTestPlugin starts an OVH API server with the fixtures of testdata/<name>.json
+// and a plugin connected to it, the extra lines are added to the connection config.
+func newTestPlugin(t *testing.T, fixtures []string, config ...string) (*ovhtest.Server, *ovhtest.Plugin) {
+	t.Helper()
+	server := ovhtest.NewServer(t)
+	server.Handle(http.MethodGet, "/me", http.StatusOK, Me{Nichandle: testNichandle})
+	for _, name := range fixtures {
+		server.LoadFixtures(t, filepath.Join("testdata", name+".json"))
+	}
+	return server, ovhtest.NewPlugin(t, Plugin, server.Config(config...))
+}
+
+// execute runs the query and fails the test on error.
+func execute(t *testing.T, p *ovhtest.Plugin, query ovhtest.Query) []ovhtest.Row {
+	t.Helper()
+	rows, err := p.Execute(context.Background(), query)
+	if err != nil {
+		t.Fatalf("select from %s: %s", query.Table, err)
+	}
+	return rows
+}
+
+// sortRows sorts the rows by the string value of the column.
+func sortRows(rows []ovhtest.Row, column string) []ovhtest.Row {
+	sort.Slice(rows, func(i, j int) bool {
+		a, _ := rows[i][column].(string)
+		b, _ := rows[j][column].(string)
+		return a < b
+	})
+	return rows
+}
+
+// checkRow checks the values of the expected columns of the row.
+func checkRow(t *testing.T, row ovhtest.Row, expected ovhtest.Row) {
+	t.Helper()
+	for column, value := range expected {
+		if !reflect.DeepEqual(row[column], value) {
+			t.Errorf("column %s: expected %#v, got %#v", column, value, row[column])
+		}
+	}
+}
+
+// checkRowCount fails the test when the number of rows is not the expected one.
+func checkRowCount(t *testing.T, rows []ovhtest.Row, expected int) {
+	t.Helper()
+	if len(rows) != expected {
+		t.Fatalf("expected %d rows, got %d: %v", expected, len(rows), rows)
+	}
+}
+
+func projectQual() []ovhtest.Qual {
+	return []ovhtest.Qual{ovhtest.Equals("project_id", "p1")}
+}
+
+// tableRoutes are the routes called to list and to get the rows of each table.
+var tableRoutes = []struct {
+	table     string
+	listQuals []ovhtest.Qual
+	listPath  string
+	getQuals  []ovhtest.Qual
+	getPath   string
+}{
+	{"ovh_api_get", []ovhtest.Qual{ovhtest.Equals("path", "/me/bill")}, "/me/bill", nil, ""},
+	{"ovh_auth_current_credential", nil, "/auth/currentCredential", nil, ""},
+	{"ovh_bill", nil, "/me/bill", []ovhtest.Qual{ovhtest.Equals("id", "FR0001")}, "/me/bill/FR0001"},
+	{"ovh_bill_detail", []ovhtest.Qual{ovhtest.Equals("bill_id", "FR0001")}, "/me/bill/FR0001/details", []ovhtest.Qual{ovhtest.Equals("bill_id", "FR0001"), ovhtest.Equals("id", "D1")}, "/me/bill/FR0001/details/D1"},
+	{"ovh_ceph", nil, "/dedicated/ceph", []ovhtest.Qual{ovhtest.Equals("id", "ceph-1")}, "/dedicated/ceph/ceph-1"},
+	{"ovh_cloud_ai_app", projectQual(), "/cloud/project/p1/ai/app", append(projectQual(), ovhtest.Equals("id", "app-1")), "/cloud/project/p1/ai/app/app-1"},
+	{"ovh_cloud_ai_job", projectQual(), "/cloud/project/p1/ai/job", append(projectQual(), ovhtest.Equals("id", "job-1")), "/cloud/project/p1/ai/job/job-1"},
+	{"ovh_cloud_ai_notebook", projectQual(), "/cloud/project/p1/ai/notebook", append(projectQual(), ovhtest.Equals("id", "nb-1")), "/cloud/project/p1/ai/notebook/nb-1"},
+	{"ovh_cloud_data_job", projectQual(), "/cloud/project/p1/dataProcessing/jobs", append(projectQual(), ovhtest.Equals("id", "dj-1")), "/cloud/project/p1/dataProcessing/jobs/dj-1"},
+	{"ovh_cloud_database", projectQual(), "/cloud/project/p1/database/service", append(projectQual(), ovhtest.Equals("id", "db-1")), "/cloud/project/p1/database/service/db-1"},
+	{"ovh_cloud_flavor", projectQual(), "/cloud/project/p1/flavor", append(projectQual(), ovhtest.Equals("id", "fl-1")), "/cloud/project/p1/flavor/fl-1"},
+	{"ovh_cloud_image", projectQual(), "/cloud/project/p1/image", append(projectQual(), ovhtest.Equals("id", "im-1")), "/cloud/project/p1/image/im-1"},
+	{"ovh_cloud_instance", projectQual(), "/cloud/project/p1/instance", append(projectQual(), ovhtest.Equals("id", "in-1")), "/cloud/project/p1/instance/in-1"},
+	{"ovh_cloud_kube", projectQual(), "/cloud/project/p1/kube", append(projectQual(), ovhtest.Equals("id", "kube-1")), "/cloud/project/p1/kube/kube-1"},
+	{"ovh_cloud_kube_customization", append(projectQual(), ovhtest.Equals("kube_id", "kube-1")), "/cloud/project/p1/kube/kube-1/customization", append(projectQual(), ovhtest.Equals("kube_id", "kube-1")), "/cloud/project/p1/kube/kube-1/customization"},
+	{"ovh_cloud_kube_ip_restriction", append(projectQual(), ovhtest.Equals("kube_id", "kube-1")), "/cloud/project/p1/kube/kube-1/ipRestrictions", append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("ip", "192.0.2.0/24")), "/cloud/project/p1/kube/kube-1/ipRestrictions"},
+	{"ovh_cloud_kube_node", append(projectQual(), ovhtest.Equals("kube_id", "kube-1")), "/cloud/project/p1/kube/kube-1/node", append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("id", "node-1")), "/cloud/project/p1/kube/kube-1/node/node-1"},
+	{"ovh_cloud_kube_nodepool", append(projectQual(), ovhtest.Equals("kube_id", "kube-1")), "/cloud/project/p1/kube/kube-1/nodepool", append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("id", "np-1")), "/cloud/project/p1/kube/kube-1/nodepool/np-1"},
+	{"ovh_cloud_network_private", projectQual(), "/cloud/project/p1/network/private", append(projectQual(), ovhtest.Equals("id", "pn-1_0")), "/cloud/project/p1/network/private/pn-1_0"},
+	{"ovh_cloud_network_subnet", append(projectQual(), ovhtest.Equals("network_id", "pn-1_0")), "/cloud/project/p1/network/private/pn-1_0/subnet", nil, ""},
+	{"ovh_cloud_postgres", projectQual(), "/cloud/project/p1/database/postgresql", append(projectQual(), ovhtest.Equals("id", "pg-1")), "/cloud/project/p1/database/postgresql/pg-1"},
+	{"ovh_cloud_project", nil, "/cloud/project", []ovhtest.Qual{ovhtest.Equals("id", "p1")}, "/cloud/project/p1"},
+	{"ovh_cloud_region", projectQual(), "/cloud/project/p1/region", append(projectQual(), ovhtest.Equals("name", "GRA11")), "/cloud/project/p1/region/GRA11"},
+	{"ovh_cloud_ssh_key", projectQual(), "/cloud/project/p1/sshkey", append(projectQual(), ovhtest.Equals("id", "sk-1")), "/cloud/project/p1/sshkey/sk-1"},
+	{"ovh_cloud_storage_s3", append(projectQual(), ovhtest.Equals("region", "GRA")), "/cloud/project/p1/region/GRA/storage", append(projectQual(), ovhtest.Equals("region", "GRA"), ovhtest.Equals("name", "backups")), "/cloud/project/p1/region/GRA/storage/backups"},
+	{"ovh_cloud_storage_swift", projectQual(), "/cloud/project/p1/storage", append(projectQual(), ovhtest.Equals("id", "c-1")), "/cloud/project/p1/storage/c-1"},
+	{"ovh_cloud_volume", projectQual(), "/cloud/project/p1/volume", append(projectQual(), ovhtest.Equals("id", "vo-1")), "/cloud/project/p1/volume/vo-1"},
+	{"ovh_cloud_volume_snapshot", projectQual(), "/cloud/project/p1/volume/snapshot", append(projectQual(), ovhtest.Equals("id", "vs-1")), "/cloud/project/p1/volume/snapshot/vs-1"},
+	{"ovh_dedicated_server", nil, "/dedicated/server", []ovhtest.Qual{ovhtest.Equals("name", "ns1.ip-192-0-2.eu")}, "/dedicated/server/ns1.ip-192-0-2.eu"},
+	{"ovh_iam_resource", nil, "/v2/iam/resource", nil, ""},
+	{"ovh_log_self", nil, "/me/api/logs/self", []ovhtest.Qual{ovhtest.Equals("id", "11")}, "/me/api/logs/self/11"},
+	{"ovh_plugin_api_call", nil, "", nil, ""},
+	{"ovh_refund", nil, "/me/refund", []ovhtest.Qual{ovhtest.Equals("id", "AFR01")}, "/me/refund/AFR01"},
+	{"ovh_refund_detail", []ovhtest.Qual{ovhtest.Equals("refund_id", "AFR01")}, "/me/refund/AFR01/details", []ovhtest.Qual{ovhtest.Equals("refund_id", "AFR01"), ovhtest.Equals("id", "RD1")}, "/me/refund/AFR01/details/RD1"},
+	{"ovh_savings_plan_subscribed", projectQual(), "/services/987/savingsPlans/subscribed", append(projectQual(), ovhtest.Equals("savings_plan_id", "sp-1")), "/services/987/savingsPlans/subscribed/sp-1"},
+}
+
+// lazyGetTables are the tables whose get builds the row from the quals.
+var lazyGetTables = map[string]bool{
+	"ovh_cloud_data_job": true,
+	"ovh_cloud_postgres": true,
+	"ovh_cloud_project":  true,
+	"ovh_cloud_region":   true,
+}
+
+// isKeyOrCommonColumn returns whether the column of a lazy get row is set from the quals.
+func isKeyOrCommonColumn(column string, quals []ovhtest.Qual) bool {
+	switch column {
+	case "account", "akas", "_ctx", "sp_ctx", "sp_connection_name":
+		return true
+	}
+	for _, qual := range quals {
+		if qual.Column == column || (column == "title" && qual.Column == "name") {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPluginTables(t *testing.T) {
+	p := Plugin(context.Background())
+	tested := map[string]bool{}
+	for _, route := range tableRoutes {
+		tested[route.table] = true
+	}
+	for name, table := range p.TableMap {
+		if table.Name != name {
+			t.Errorf("table %s is registered as %s", table.Name, name)
+		}
+		if !tested[name] {
+			t.Errorf("table %s has no routes in tableRoutes", name)
+		}
+	}
+}
+
+// singleObjectTables are the tables listing a single object, their list is never empty.
+var singleObjectTables = map[string]bool{
+	"ovh_auth_current_credential":  true,
+	"ovh_cloud_kube_customization": true,
+}
+
+// localTables are the tables whose rows are not fetched from the API.
+var localTables = map[string]bool{
+	"ovh_plugin_api_call": true,
+}
+
+func TestTablesListEmpty(t *testing.T) {
+	for _, route := range tableRoutes {
+		if singleObjectTables[route.table] || localTables[route.table] {
+			continue
+		}
+		t.Run(route.table, func(t *testing.T) {
+			server, p := newTestPlugin(t, []string{route.table})
+			server.Handle(http.MethodGet, route.listPath, http.StatusOK, []string{})
+
+			rows := execute(t, p, ovhtest.Query{Table: route.table, Quals: route.listQuals})
+
+			checkRowCount(t, rows, 0)
+		})
+	}
+}
+
+func TestTablesListError(t *testing.T) {
+	for _, route := range tableRoutes {
+		if localTables[route.table] {
+			continue
+		}
+		t.Run(route.table, func(t *testing.T) {
+			server, p := newTestPlugin(t, []string{route.table})
+			server.HandleError(http.MethodGet, route.listPath, http.StatusForbidden, "This call has not been granted")
+
+			_, err := p.Execute(context.Background(), ovhtest.Query{Table: route.table, Quals: route.listQuals})
+
+			if err == nil || !strings.Contains(err.Error(), "This call has not been granted") {
+				t.Fatalf("expected the API error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTablesGetNotFound(t *testing.T) {
+	for _, route := range tableRoutes {
+		if route.getQuals == nil {
+			continue
+		}
+		t.Run(route.table, func(t *testing.T) {
+			server, p := newTestPlugin(t, []string{route.table})
+			server.HandleError(http.MethodGet, route.getPath, http.StatusNotFound, "The requested object does not exist")
+
+			rows := execute(t, p, ovhtest.Query{Table: route.table, Quals: route.getQuals})
+
+			// the get of some tables only sets the key columns, the other columns
+			// are hydrated later and are null when the object does not exist
+			if lazyGetTables[route.table] {
+				checkRowCount(t, rows, 1)
+				for column, value := range rows[0] {
+					if value != nil && !isKeyOrCommonColumn(column, route.getQuals) {
+						t.Errorf("column %s: expected nil, got %#v", column, value)
+					}
+				}
+				return
+			}
+			checkRowCount(t, rows, 0)
+		})
+	}
+}
+
+func TestTablesGetError(t *testing.T) {
+	for _, route := range tableRoutes {
+		if route.getQuals == nil {
+			continue
+		}
+		t.Run(route.table, func(t *testing.T) {
+			server, p := newTestPlugin(t, []string{route.table})
+			server.HandleError(http.MethodGet, route.getPath, http.StatusForbidden, "This call has not been granted")
+
+			_, err := p.Execute(context.Background(), ovhtest.Query{Table: route.table, Quals: route.getQuals})
+
+			if err == nil || !strings.Contains(err.Error(), "This call has not been granted") {
+				t.Fatalf("expected the API error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidCredentials(t *testing.T) {
+	server := ovhtest.NewServer(t)
+	config := strings.Replace(server.Config(), ovhtest.ApplicationSecret, "wrong-secret", 1)
+	p := ovhtest.NewPlugin(t, Plugin, config)
+
+	_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}})
+
+	if err == nil || !strings.Contains(err.Error(), "Invalid signature") {
+		t.Fatalf("expected an invalid signature error, got %v", err)
+	}
+}
+
+func TestMixedAuthenticationMethods(t *testing.T) {
+	t.Setenv("OVH_CLIENT_ID", "env-client-id")
+	_, p := newTestPlugin(t, nil)
+
+	_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_api_get", Quals: []ovhtest.Qual{ovhtest.Equals("path", "/me")}})
+
+	if err == nil || !strings.Contains(err.Error(), "the OVH_CLIENT_ID environment variable cannot be used together with 'application_key'") {
+		t.Fatalf("expected an error naming OVH_CLIENT_ID, got %v", err)
+	}
+}
+
+func TestRetryServerError(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_bill"})
+	calls := 0
+	server.HandleFunc(http.MethodGet, "/me/bill", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"Service unavailable"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`["FR0001"]`))
+	})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}})
+
+	checkRowCount(t, rows, 1)
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRetryRateLimitedRequest(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_kube_nodepool"})
+	calls := 0
+	server.HandleFunc(http.MethodGet, "/cloud/project/p1/kube/kube-2/nodepool", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"Too many requests"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":"np-2","projectId":"p1"}]`))
+	})
+
+	// the rows of the first cluster, streamed before the rate limited request, are not streamed again
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_nodepool", Columns: []string{"id"}, Quals: projectQual()})
+
+	checkRowCount(t, rows, 2)
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestStandardColumns(t *testing.T) {
+	tests := []struct {
+		table    string
+		quals    []ovhtest.Qual
+		expected ovhtest.Row
+	}{
+		{"ovh_bill", []ovhtest.Qual{ovhtest.Equals("id", "FR0001")}, ovhtest.Row{
+			"akas":  []interface{}{"urn:v1:eu:resource:account:xx1234-ovh/bill/FR0001"},
+			"title": "FR0001",
+			"tags":  nil,
+		}},
+		{"ovh_cloud_instance", append(projectQual(), ovhtest.Equals("id", "in-1")), ovhtest.Row{
+			"akas":  []interface{}{"urn:v1:eu:resource:publicCloudProject:p1/instance/in-1"},
+			"title": "web-1",
+			"tags":  nil,
+		}},
+		{"ovh_cloud_project", []ovhtest.Qual{ovhtest.Equals("id", "p1")}, ovhtest.Row{
+			"akas":  []interface{}{"urn:v1:eu:resource:publicCloudProject:p1"},
+			"title": "Production",
+			"tags":  map[string]interface{}{"env": "prod"},
+		}},
+		{"ovh_ceph", nil, ovhtest.Row{
+			"akas":  []interface{}{"urn:v1:eu:resource:dedicatedCloudCeph:ceph-1"},
+			"title": "ceph-1",
+			"tags":  map[string]interface{}{"env": "prod"},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			_, p := newTestPlugin(t, []string{test.table})
+
+			rows := execute(t, p, ovhtest.Query{Table: test.table, Columns: []string{"akas", "title", "tags"}, Quals: test.quals})
+
+			checkRowCount(t, rows, 1)
+			checkRow(t, rows[0], test.expected)
+		})
+	}
+}
+
+func TestCloudRegionQual(t *testing.T) {
+	tests := map[string]string{
+		"ovh_cloud_flavor":   "GET /cloud/project/p1/flavor?region=GRA11",
+		"ovh_cloud_image":    "GET /cloud/project/p1/image?region=GRA11",
+		"ovh_cloud_instance": "GET /cloud/project/p1/instance?region=GRA11",
+		"ovh_cloud_ssh_key":  "GET /cloud/project/p1/sshkey?region=GRA11",
+		"ovh_cloud_volume":   "GET /cloud/project/p1/volume?region=GRA11",
+	}
+	for table, expected := range tests {
+		t.Run(table, func(t *testing.T) {
+			server, p := newTestPlugin(t, []string{table})
+
+			rows := execute(t, p, ovhtest.Query{
+				Table:   table,
+				Columns: []string{"id", "region"},
+				Quals:   append(projectQual(), ovhtest.Equals("region", "GRA11")),
+			})
+
+			checkRowCount(t, rows, 1)
+			checkRow(t, rows[0], ovhtest.Row{"region": "GRA11"})
+			if requests := server.Requests(); !slices.Contains(requests, expected) {
+				t.Errorf("expected the request %s, got %v", expected, requests)
+			}
+		})
+	}
+}
+
+func TestUrnRegion(t *testing.T) {
+	tests := map[string]string{
+		"https://eu.api.ovh.com/1.0":        "eu",
+		"https://ca.api.ovh.com/1.0":        "ca",
+		"https://api.us.ovhcloud.com/1.0":   "us",
+		"https://eu.api.kimsufi.com/1.0":    "eu",
+		"https://ca.api.soyoustart.com/1.0": "ca",
+	}
+	for endpoint, expected := range tests {
+		if region := urnRegion(endpoint); region != expected {
+			t.Errorf("%s: expected %s, got %s", endpoint, expected, region)
+		}
+	}
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.UTC()
+}
@@ -0,0 +1,241 @@
+package ovh
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// redactedHeaders are the credential headers replaced in the recordings
+var redactedHeaders = []string{
+	"Authorization",
+	"Set-Cookie",
+	"X-Ovh-Application",
+	"X-Ovh-Consumer",
+	"X-Ovh-Signature",
+}
+
+// recordingKeyHeaders are the request headers that change the response of a request,
+// they are part of the key of a recording with the method, path, query and body
+var recordingKeyHeaders = []string{
+	"X-Ovh-Batch",
+	"X-Pagination-Cursor",
+	"X-Pagination-Size",
+}
+
+const redacted = "REDACTED"
+
+type recording struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method  string          `json:"method"`
+	URL     string          `json:"url"`
+	Headers http.Header     `json:"headers"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	Status  int             `json:"status"`
+	Headers http.Header     `json:"headers"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// recordTransport sends the requests with the next transport and writes each
+// request and its response, without the credentials and the download links of
+// the billing documents, to a file of the directory.
+type recordTransport struct {
+	dir  string
+	next http.RoundTripper
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if isAuthTimeRequest(req) {
+		return resp, nil
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	recordedBody := respBody
+	if isBillingRequest(req) {
+		if recordedBody, err = omitBillingFields(respBody); err != nil {
+			return nil, err
+		}
+	}
+
+	rec := recording{
+		Request: recordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.RequestURI(),
+			Headers: redactHeaders(req.Header),
+			Body:    toRawJSON(reqBody),
+		},
+		Response: recordedResponse{
+			Status:  resp.StatusCode,
+			Headers: redactHeaders(resp.Header),
+			Body:    toRawJSON(recordedBody),
+		},
+	}
+	content, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(filepath.Join(t.dir, recordingFilename(req, reqBody)), content); err != nil {
+		return nil, fmt.Errorf("cannot record %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	return resp, nil
+}
+
+// replayTransport answers the requests with the recordings of the directory, no request is sent.
+type replayTransport struct {
+	dir string
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the server time is not recorded, the signature timestamp is computed from the current time
+	if isAuthTimeRequest(req) {
+		return replayResponse(req, http.StatusOK, nil, []byte(fmt.Sprint(time.Now().Unix()))), nil
+	}
+
+	filename := filepath.Join(t.dir, recordingFilename(req, reqBody))
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recorded response for %s %s in %s", req.Method, req.URL.RequestURI(), t.dir)
+		}
+		return nil, err
+	}
+	var rec recording
+	if err := json.Unmarshal(content, &rec); err != nil {
+		return nil, fmt.Errorf("invalid recording %s: %w", filename, err)
+	}
+	return replayResponse(req, rec.Response.Status, rec.Response.Headers, rec.Response.Body), nil
+}
+
+// isAuthTimeRequest returns whether the request gets the server time used to sign the requests.
+func isAuthTimeRequest(req *http.Request) bool {
+	return req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/auth/time")
+}
+
+// isBillingRequest returns whether the request gets bills or refunds, their download links
+// and password are not recorded.
+func isBillingRequest(req *http.Request) bool {
+	return strings.Contains(req.URL.Path, "/me/bill/") || strings.Contains(req.URL.Path, "/me/refund/")
+}
+
+func replayResponse(req *http.Request, status int, headers http.Header, body []byte) *http.Response {
+	if headers == nil {
+		headers = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readBody reads a request or response body and replaces it with a copy.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	content, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(content))
+	return content, nil
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	headers = headers.Clone()
+	for _, name := range redactedHeaders {
+		if headers.Get(name) != "" {
+			headers.Set(name, redacted)
+		}
+	}
+	return headers
+}
+
+// toRawJSON keeps a JSON body as is, other bodies are stored as a JSON string.
+func toRawJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return body
+	}
+	content, _ := json.Marshal(string(body))
+	return content
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// recordingFilename is the file of the recording of a request, a readable
+// method and path followed by the hash of everything that identifies the request.
+func recordingFilename(req *http.Request, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", req.Method, req.URL.RequestURI())
+	for _, name := range recordingKeyHeaders {
+		fmt.Fprintf(h, "%s: %s\n", name, req.Header.Get(name))
+	}
+	h.Write(body)
+
+	name := strings.Trim(unsafeFilenameChars.ReplaceAllString(req.URL.Path, "_"), "_")
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	return fmt.Sprintf("%s_%s_%x.json", req.Method, name, h.Sum(nil)[:6])
+}
+
+// writeFileAtomic writes the file atomically, concurrent requests may write the same file
+// of the recordings or of the billing cache.
+func writeFileAtomic(filename string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(filename), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filename)
+}
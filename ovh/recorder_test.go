@@ -0,0 +1,114 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	_, p := newTestPlugin(t, []string{"ovh_bill"}, fmt.Sprintf("record_dir = %q", dir))
+	recorded := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_bill"}), "id")
+	checkRowCount(t, recorded, 2)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// /auth/currentCredential, /me, /me/bill and the batch of bills
+	if len(files) != 4 {
+		t.Fatalf("expected 4 recordings, got %v", files)
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, secret := range []string{ovhtest.ApplicationKey, ovhtest.ConsumerKey, "$1$", "secret1", "facture.pdf"} {
+			if strings.Contains(string(content), secret) {
+				t.Errorf("recording %s contains %q", file, secret)
+			}
+		}
+	}
+
+	// the replay connection has no credentials and the server has no route
+	server := ovhtest.NewServer(t)
+	p = ovhtest.NewPlugin(t, Plugin, fmt.Sprintf("endpoint = %q\nreplay_dir = %q", server.Endpoint(), dir))
+	replayed := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_bill"}), "id")
+
+	// the download links are not recorded
+	for _, row := range recorded {
+		row["url"], row["pdf_url"], row["password"] = nil, nil, nil
+	}
+	if !reflect.DeepEqual(recorded, replayed) {
+		t.Errorf("expected the recorded rows %v, got %v", recorded, replayed)
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("expected no request in replay mode, got %v", requests)
+	}
+}
+
+func TestRecordOmitsBillingFields(t *testing.T) {
+	dir := t.TempDir()
+	_, p := newTestPlugin(t, []string{"ovh_bill"}, fmt.Sprintf("record_dir = %q", dir))
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill", Quals: []ovhtest.Qual{ovhtest.Equals("id", "FR0001")}})
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"password": "secret1"})
+
+	files, err := filepath.Glob(filepath.Join(dir, "GET_1.0_me_bill_FR0001_*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected a recording of the bill, got %v", files)
+	}
+	content, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rec recording
+	if err := json.Unmarshal(content, &rec); err != nil {
+		t.Fatal(err)
+	}
+	var bill map[string]interface{}
+	if err := json.Unmarshal(rec.Response.Body, &bill); err != nil {
+		t.Fatal(err)
+	}
+	if bill["billId"] != "FR0001" {
+		t.Errorf("expected the recorded bill FR0001, got %v", bill)
+	}
+	for _, field := range billingCacheOmittedFields {
+		if _, ok := bill[field]; ok {
+			t.Errorf("recorded bill contains %q", field)
+		}
+	}
+}
+
+func TestReplayMissingRecording(t *testing.T) {
+	server := ovhtest.NewServer(t)
+	p := ovhtest.NewPlugin(t, Plugin, fmt.Sprintf("endpoint = %q\nreplay_dir = %q", server.Endpoint(), t.TempDir()))
+
+	_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_bill"})
+
+	if err == nil || !strings.Contains(err.Error(), "no recorded response for GET /1.0/me/bill") {
+		t.Errorf("expected a missing recording error, got %v", err)
+	}
+}
+
+func TestRecordAndReplayDir(t *testing.T) {
+	_, p := newTestPlugin(t, nil, `record_dir = "a"`, `replay_dir = "b"`)
+
+	_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_bill"})
+
+	if err == nil || !strings.Contains(err.Error(), "'record_dir' and 'replay_dir' cannot be used together") {
+		t.Errorf("expected a config error, got %v", err)
+	}
+}
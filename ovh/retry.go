@@ -0,0 +1,73 @@
+package ovh
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// maxRetries is the number of times a rate limited or failed request is sent again
+	maxRetries = 5
+	// minRetryDelay is the delay before the first retry, it doubles on each retry
+	minRetryDelay = 500 * time.Millisecond
+	// maxRetryDelay caps the delays, including the ones asked by a Retry-After header
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryTransport sends again the requests rate limited (429) or failed with a transient
+// server error (5xx), after the delay of the Retry-After header if any. The retries are
+// done for each request, so that a listing already streaming rows is not started again.
+type retryTransport struct {
+	next http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	delay := minRetryDelay
+	for retry := 0; ; retry++ {
+		attempt := req
+		if retry > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attempt = req.Clone(req.Context())
+			attempt.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attempt)
+		if err != nil || !isRetryableStatus(resp.StatusCode) || retry == maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		wait := retryAfter(resp.Header.Get("Retry-After"), delay)
+		resp.Body.Close()
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryAfter returns the delay of a Retry-After header, in seconds or an HTTP date,
+// or the default delay when the header is missing or invalid.
+func retryAfter(header string, delay time.Duration) time.Duration {
+	if header == "" {
+		return delay
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxRetryDelay)
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return min(max(time.Until(date), 0), maxRetryDelay)
+	}
+	return delay
+}
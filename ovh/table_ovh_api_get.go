@@ -0,0 +1,153 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type ApiGetRow struct {
+	Path  string
+	Query map[string]interface{}
+	Value json.RawMessage
+}
+
+func tableOvhApiGet() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_api_get",
+		Description: "Response of a GET request to any path of the OVH API.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "path", Require: plugin.Required},
+				{Name: "query", Require: plugin.Optional},
+			},
+			Hydrate: listApiGet,
+			// the paths are typed by hand, an unknown path fails instead of returning no row
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: func(context.Context, *plugin.QueryData, *plugin.HydrateData, error) bool {
+				return false
+			}},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "Path of the request, relative to the API endpoint (e.g. /me or /cloud/project).",
+			},
+			{
+				Name:        "query",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Query"),
+				Description: "Query parameters of the request, as a JSON object.",
+			},
+			{
+				Name:        "value",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Value"),
+				Description: "Element of the response when it is an array, the whole response otherwise.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Path"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+func listApiGet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_get.listApiGet", "connection_error", err)
+		return nil, err
+	}
+
+	path := d.EqualsQuals["path"].GetStringValue()
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("the path %q must start with /", path)
+	}
+	if strings.Contains(path, "?") {
+		return nil, fmt.Errorf("the path %q must not contain query parameters, use the query column", path)
+	}
+
+	row := ApiGetRow{Path: path}
+	if qual := d.EqualsQuals["query"]; qual != nil {
+		query := qual.GetJsonbValue()
+		if query == "" {
+			query = qual.GetStringValue()
+		}
+		if err := json.Unmarshal([]byte(query), &row.Query); err != nil || row.Query == nil {
+			return nil, fmt.Errorf("the query %s must be a JSON object", query)
+		}
+		params, err := apiGetQueryParams(row.Query)
+		if err != nil {
+			return nil, err
+		}
+		if encoded := params.Encode(); encoded != "" {
+			path += "?" + encoded
+		}
+	}
+
+	var response json.RawMessage
+	err = client.GetWithContext(ctx, path, &response)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_get.listApiGet", err)
+		return nil, err
+	}
+
+	// the elements are kept as raw JSON, numbers like IDs may not fit in a float64
+	var elements []json.RawMessage
+	if err := json.Unmarshal(response, &elements); err != nil {
+		row.Value = response
+		d.StreamListItem(ctx, row)
+		return nil, nil
+	}
+	for _, element := range elements {
+		row.Value = element
+		d.StreamListItem(ctx, row)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// apiGetQueryParams converts a JSON object to query parameters, an array value is a repeated parameter.
+func apiGetQueryParams(object map[string]interface{}) (url.Values, error) {
+	params := url.Values{}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values, ok := object[key].([]interface{})
+		if !ok {
+			values = []interface{}{object[key]}
+		}
+		for _, value := range values {
+			switch v := value.(type) {
+			case string:
+				params.Add(key, v)
+			case float64:
+				params.Add(key, strconv.FormatFloat(v, 'f', -1, 64))
+			case bool:
+				params.Add(key, strconv.FormatBool(v))
+			default:
+				return nil, fmt.Errorf("the query parameter %q must be a string, a number, a boolean or an array of them", key)
+			}
+		}
+	}
+	return params, nil
+}
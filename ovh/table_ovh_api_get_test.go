@@ -0,0 +1,86 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestApiGetArray(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_api_get"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_api_get", Quals: []ovhtest.Qual{ovhtest.Equals("path", "/domain")}}), "value")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{"path": "/domain", "query": nil, "value": "example.com"})
+	checkRow(t, rows[1], ovhtest.Row{"path": "/domain", "query": nil, "value": "example.org"})
+}
+
+func TestApiGetObject(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_api_get"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_api_get", Quals: []ovhtest.Qual{ovhtest.Equals("path", "/domain/example.com")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"value": map[string]interface{}{"domain": "example.com", "offer": "gold"},
+		"title": "/domain/example.com",
+		"tags":  nil,
+	})
+}
+
+func TestApiGetQuery(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_api_get"})
+	query := json.RawMessage(`{"date.from": "2024-01-01", "state": ["a", "b"], "limit": 10}`)
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_api_get", Columns: []string{"path", "query", "value"}, Quals: []ovhtest.Qual{
+		ovhtest.Equals("path", "/me/bill"),
+		ovhtest.Equals("query", query),
+	}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"path":  "/me/bill",
+		"query": map[string]interface{}{"date.from": "2024-01-01", "state": []interface{}{"a", "b"}, "limit": float64(10)},
+		"value": "FR0001",
+	})
+	expected := []string{"GET /auth/currentCredential", "GET /me/bill?date.from=2024-01-01&limit=10&state=a&state=b"}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected the requests %v, got %v", expected, requests)
+	}
+}
+
+func TestApiGetUnknownPath(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_api_get"})
+
+	_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_api_get", Quals: []ovhtest.Qual{ovhtest.Equals("path", "/me/typo")}})
+
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestApiGetInvalidQuals(t *testing.T) {
+	tests := []struct {
+		quals    []ovhtest.Qual
+		expected string
+	}{
+		{[]ovhtest.Qual{ovhtest.Equals("path", "me")}, "must start with /"},
+		{[]ovhtest.Qual{ovhtest.Equals("path", "/me/bill?limit=1")}, "must not contain query parameters"},
+		{[]ovhtest.Qual{ovhtest.Equals("path", "/me/bill"), ovhtest.Equals("query", json.RawMessage(`["a"]`))}, "must be a JSON object"},
+		{[]ovhtest.Qual{ovhtest.Equals("path", "/me/bill"), ovhtest.Equals("query", json.RawMessage(`{"a": {"b": 1}}`))}, `the query parameter "a" must be`},
+	}
+	for _, test := range tests {
+		_, p := newTestPlugin(t, nil)
+
+		_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_api_get", Quals: test.quals})
+
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%v: expected an error containing %q, got %v", test.quals, test.expected, err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type Credential struct {
+	CredentialID  int64            `json:"credentialId"`
+	ApplicationID int64            `json:"applicationId"`
+	Creation      time.Time        `json:"creation"`
+	Expiration    *time.Time       `json:"expiration"`
+	LastUse       *time.Time       `json:"lastUse"`
+	Status        string           `json:"status"`
+	OvhSupport    bool             `json:"ovhSupport"`
+	AllowedIPs    []string         `json:"allowedIPs"`
+	Rules         []ovh.AccessRule `json:"rules"`
+}
+
+func (credential Credential) urn(b urnBuilder) string {
+	return b.accountResource(fmt.Sprintf("api/credential/%d", credential.CredentialID))
+}
+
+type Application struct {
+	ApplicationID  int64  `json:"applicationId"`
+	ApplicationKey string `json:"applicationKey"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Status         string `json:"status"`
+}
+
+func tableOvhAuthCurrentCredential() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_auth_current_credential",
+		Description: "Credential (consumer key) of the connection.",
+		List: &plugin.ListConfig{
+			Hydrate: listCurrentCredential,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "credential_id",
+				Type:        proto.ColumnType_INT,
+				Description: "ID of the credential.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the credential (validated, pendingValidation, expired or refused).",
+			},
+			{
+				Name:        "rules",
+				Type:        proto.ColumnType_JSON,
+				Description: "Access rules of the credential, the allowed methods and paths.",
+			},
+			{
+				Name:        "allowed_ips",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AllowedIPs"),
+				Description: "IP blocks allowed to use the credential, any IP if null.",
+			},
+			{
+				Name:        "creation",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Creation date of the credential.",
+			},
+			{
+				Name:        "expiration",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Expiration date of the credential, null if it never expires.",
+			},
+			{
+				Name:        "last_use",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last use of the credential.",
+			},
+			{
+				Name:        "ovh_support",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("OvhSupport"),
+				Description: "Whether the credential was created by the OVH support.",
+			},
+			{
+				Name:        "application_id",
+				Type:        proto.ColumnType_INT,
+				Description: "ID of the application of the credential.",
+			},
+			{
+				Name:        "application_key",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCredentialApplication,
+				Transform:   transform.FromField("ApplicationKey"),
+				Description: "Key of the application.",
+			},
+			{
+				Name:        "application_name",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCredentialApplication,
+				Transform:   transform.FromField("Name"),
+				Description: "Name of the application.",
+			},
+			{
+				Name:        "application_description",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCredentialApplication,
+				Transform:   transform.FromField("Description"),
+				Description: "Description of the application.",
+			},
+			{
+				Name:        "application_status",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCredentialApplication,
+				Transform:   transform.FromField("Status"),
+				Description: "Status of the application.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CredentialID"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+func listCurrentCredential(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_auth_current_credential.listCurrentCredential", "connection_error", err)
+		return nil, err
+	}
+
+	var credential Credential
+	err = client.Get("/auth/currentCredential", &credential)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_auth_current_credential.listCurrentCredential", err)
+		return nil, err
+	}
+	d.StreamListItem(ctx, credential)
+
+	return nil, nil
+}
+
+func getCredentialApplication(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_auth_current_credential.getCredentialApplication", "connection_error", err)
+		return nil, err
+	}
+
+	credential := h.Item.(Credential)
+	var application Application
+	err = client.Get(fmt.Sprintf("/me/api/application/%d", credential.ApplicationID), &application)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_auth_current_credential.getCredentialApplication", err)
+		return nil, err
+	}
+	return application, nil
+}
@@ -0,0 +1,134 @@
+package ovh
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func TestAuthCurrentCredentialList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_auth_current_credential"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_auth_current_credential"})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"credential_id":           int64(123456),
+		"status":                  "validated",
+		"rules":                   []interface{}{map[string]interface{}{"method": "GET", "path": "/*"}},
+		"allowed_ips":             []interface{}{"192.0.2.0/24"},
+		"creation":                mustParseTime(t, "2024-01-01T09:00:00Z"),
+		"expiration":              mustParseTime(t, "2099-01-01T09:00:00Z"),
+		"last_use":                mustParseTime(t, "2024-06-01T08:00:00Z"),
+		"ovh_support":             false,
+		"application_id":          int64(42),
+		"application_key":         "test-application-key",
+		"application_name":        "steampipe",
+		"application_description": "Steampipe plugin",
+		"application_status":      "active",
+		"akas":                    []interface{}{"urn:v1:eu:resource:account:xx1234-ovh/api/credential/123456"},
+		"title":                   "123456",
+	})
+}
+
+func TestCredentialValidation(t *testing.T) {
+	tests := []struct {
+		status     string
+		expiration interface{}
+		expected   string
+	}{
+		{"pendingValidation", nil, "the consumer key 1 is pending validation"},
+		{"expired", nil, "the consumer key 1 has expired"},
+		{"validated", "2020-01-01T00:00:00Z", "the consumer key 1 has expired"},
+		{"refused", nil, "the consumer key 1 is refused"},
+	}
+	for _, test := range tests {
+		t.Run(test.status, func(t *testing.T) {
+			credential := map[string]interface{}{}
+			for key, value := range ovhtest.Credential {
+				credential[key] = value
+			}
+			credential["status"] = test.status
+			credential["expiration"] = test.expiration
+			server, p := newTestPlugin(t, []string{"ovh_bill"})
+			server.Handle(http.MethodGet, "/auth/currentCredential", http.StatusOK, credential)
+
+			_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_bill"})
+
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("expected an error containing %q, got %v", test.expected, err)
+			}
+			for _, request := range server.Requests() {
+				if strings.HasPrefix(request, "GET /me/bill") {
+					t.Errorf("unexpected request with an invalid consumer key: %s", request)
+				}
+			}
+		})
+	}
+}
+
+func TestCredentialNotAllowed(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_bill", "ovh_cloud_project"})
+	server.HandleError(http.MethodGet, "/auth/currentCredential", http.StatusForbidden, "This call has not been granted")
+
+	// the tables work without the check of the consumer key, which is not retried
+	checkRowCount(t, execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}}), 2)
+	checkRowCount(t, execute(t, p, ovhtest.Query{Table: "ovh_cloud_project", Columns: []string{"id"}}), 2)
+
+	calls := 0
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET /auth/currentCredential") {
+			calls++
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the consumer key to be checked once, got %d calls", calls)
+	}
+}
+
+func TestCredentialMissingRule(t *testing.T) {
+	credential := map[string]interface{}{}
+	for key, value := range ovhtest.Credential {
+		credential[key] = value
+	}
+	credential["rules"] = []map[string]string{{"method": "GET", "path": "/me"}, {"method": "GET", "path": "/cloud/*"}}
+	server, p := newTestPlugin(t, []string{"ovh_bill"})
+	server.Handle(http.MethodGet, "/auth/currentCredential", http.StatusOK, credential)
+	server.HandleError(http.MethodGet, "/me/bill", http.StatusForbidden, "This call has not been granted")
+
+	_, err := p.Execute(context.Background(), ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}})
+
+	expected := `This call has not been granted: GET /me/bill is not allowed by the access rules of the consumer key (GET /me, GET /cloud/*)`
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected an error containing %q, got %v", expected, err)
+	}
+}
+
+func TestAllowedByRules(t *testing.T) {
+	rules := []ovh.AccessRule{
+		{Method: "GET", Path: "/me"},
+		{Method: "GET", Path: "/cloud/project/*/instance"},
+		{Method: "POST", Path: "/*"},
+	}
+	tests := []struct {
+		method  string
+		path    string
+		allowed bool
+	}{
+		{"GET", "/me", true},
+		{"GET", "/me/bill", false},
+		{"GET", "/cloud/project/p1/instance", true},
+		{"GET", "/cloud/project/p1/volume", false},
+		{"POST", "/me/bill", true},
+		{"DELETE", "/me", false},
+	}
+	for _, test := range tests {
+		if allowed := allowedByRules(rules, test.method, test.path); allowed != test.allowed {
+			t.Errorf("allowedByRules(%s %s) = %t, expected %t", test.method, test.path, allowed, test.allowed)
+		}
+	}
+}
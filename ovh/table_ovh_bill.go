@@ -28,21 +28,24 @@ type Bill struct {
 	Tax             Price     `json:"tax"`
 }
 
+func (bill Bill) urn(b urnBuilder) string {
+	return b.accountResource("bill/" + bill.ID)
+}
+
 func tableOvhBill() *plugin.Table {
 	return &plugin.Table{
 		Name:        "ovh_bill",
 		Description: "Bills of your account.",
 		List: &plugin.ListConfig{
-			Hydrate: listBill,
+			KeyColumns: dateRangeKeyColumns("date"),
+			Hydrate:    listBill,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getBill,
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getBill,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getBillInfo},
-		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -50,114 +53,147 @@ func tableOvhBill() *plugin.Table {
 			},
 			{
 				Name:        "date",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Date of the bill.",
 			},
 			{
 				Name:        "url",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBillDownload,
 				Transform:   transform.FromField("Url"),
 				Description: "URL to download the bill.",
 			},
 			{
 				Name:        "pdf_url",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBillDownload,
 				Transform:   transform.FromField("PdfUrl"),
 				Description: "URL to download the bill in PDF format (maybe same as url field).",
 			},
 			{
 				Name:        "order_id",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("OrderId"),
 				Description: "Order id.",
 			},
 			{
 				Name:        "category",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Category of the bill (autorenew, earlyrenewal...).",
 			},
 			{
 				Name:        "password",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBillDownload,
 				Description: "Password to download the bill.",
 			},
 			{
 				Name:        "price_with_tax",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("PriceWithTax.Value"),
 				Description: "Price with tax.",
 			},
 			{
 				Name:        "price_without_tax",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("PriceWithoutTax.Value"),
 				Description: "Price without tax.",
 			},
 			{
 				Name:        "tax",
-				Hydrate:     getBillInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("Tax.Value"),
 				Description: "Amount of the tax.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
-func getBillInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	bill := h.Item.(Bill)
-
+func listBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill.getBillInfo", "connection_error", err)
+		plugin.Logger(ctx).Error("ovh_bill.listBill", "connection_error", err)
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/me/bill/%s", bill.ID), &bill)
+	var billsId []string
+	err = client.Get("/me/bill"+dateRangeQuery(d, "date"), &billsId)
+
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill.listBill", err)
+		return nil, err
+	}
 
+	cache, err := getBillingCache(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill.getBillInfo", err)
+		plugin.Logger(ctx).Error("ovh_bill.listBill", "cache_error", err)
+		return nil, err
+	}
+	err = getBatchedCached(ctx, d, client, cache, "/me/bill", billsId, func(bill Bill) {
+		d.StreamListItem(ctx, bill)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill.listBill", err)
 		return nil, err
 	}
 
-	return bill, nil
+	return nil, nil
 }
 
-func listBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill.listBill", "connection_error", err)
+		plugin.Logger(ctx).Error("ovh_bill.getBill", "connection_error", err)
 		return nil, err
 	}
 
-	var billsId []string
-	err = client.Get("/me/bill", &billsId)
-
+	cache, err := getBillingCache(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill.listBill", err)
+		plugin.Logger(ctx).Error("ovh_bill.getBill", "cache_error", err)
 		return nil, err
 	}
 
-	for _, billId := range billsId {
-		var bill Bill
-		bill.ID = billId
-		d.StreamListItem(ctx, bill)
+	id := d.EqualsQuals["id"].GetStringValue()
+	bill, err := getCached[Bill](ctx, client, cache, fmt.Sprintf("/me/bill/%s", id))
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill.getBill", err)
+		return nil, err
 	}
 
-	return nil, nil
+	return bill, nil
 }
 
-func getBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	id := d.EqualsQuals["id"].GetStringValue()
-	var bill Bill
-	bill.ID = id
+// getBillDownload returns the bill with its download links and password, they are not stored
+// in the billing cache and are fetched from the API for the cached bills.
+func getBillDownload(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bill := h.Item.(Bill)
+	if bill.Url != "" {
+		return bill, nil
+	}
+	// the download links are not recorded, they are null in replay mode
+	if stringValue(GetConfig(d.Connection).ReplayDir) != "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill.getBillDownload", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.GetWithContext(ctx, fmt.Sprintf("/me/bill/%s", bill.ID), &bill)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill.getBillDownload", err)
+		return nil, err
+	}
+
 	return bill, nil
 }
@@ -12,7 +12,7 @@ import (
 )
 
 type BillDetail struct {
-	ID          string `json:"id"`
+	ID          string `json:"billDetailId"`
 	BillID      string `json:"bill_id"`
 	Description string `json:"description"`
 	Domain      string `json:"domain"`
@@ -23,6 +23,10 @@ type BillDetail struct {
 	UnitPrice   Price  `json:"unitPrice"`
 }
 
+func (billDetail BillDetail) urn(b urnBuilder) string {
+	return b.accountResource("bill/" + billDetail.BillID + "/details/" + billDetail.ID)
+}
+
 func tableOvhBillDetails() *plugin.Table {
 	return &plugin.Table{
 		Name:        "ovh_bill_detail",
@@ -30,12 +34,15 @@ func tableOvhBillDetails() *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.AllColumns([]string{"bill_id"}),
 			Hydrate:    listBillingDetails,
+			// a missing bill has no details
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"bill_id", "id"}),
-			Hydrate:    getBillingDetail,
+			KeyColumns:   plugin.AllColumns([]string{"bill_id", "id"}),
+			Hydrate:      getBillingDetail,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -49,51 +56,52 @@ func tableOvhBillDetails() *plugin.Table {
 			},
 			{
 				Name:        "description",
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Description of detail.",
 			},
 			{
 				Name:        "domain",
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Domain.",
 			},
 			{
 				Name:        "period_start",
 				Transform:   transform.FromP(convertBillDetailDate, "PeriodStart"),
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Period start of the product detail.",
 			},
 			{
 				Name:        "period_end",
 				Transform:   transform.FromP(convertBillDetailDate, "PeriodEnd"),
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Period end of the product detail.",
 			},
 			{
 				Name:        "quantity",
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Quantity of detail.",
 			},
 			{
 				Name:        "total_price",
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("TotalPrice.Value"),
 				Description: "Total price of this detail.",
 			},
 			{
 				Name:        "unit_price",
-				Hydrate:     getBillDetailInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("UnitPrice.Value"),
 				Description: "Unit price of this detail.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -121,14 +129,21 @@ func getBillDetailInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/me/bill/%s/details/%s", billDetail.BillID, billDetail.ID), &billDetail)
+	cache, err := getBillingCache(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill_detail.getBillDetailInfo", "cache_error", err)
+		return nil, err
+	}
+
+	result, err := getCached[BillDetail](ctx, client, cache, fmt.Sprintf("/me/bill/%s/details/%s", billDetail.BillID, billDetail.ID))
 
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_bill_detail.getBillDetailInfo", err)
 		return nil, err
 	}
+	result.BillID = billDetail.BillID
 
-	return billDetail, nil
+	return result, nil
 }
 
 func listBillingDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -140,20 +155,27 @@ func listBillingDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 
 	billId := d.EqualsQuals["bill_id"].GetStringValue()
 
-	// First, we get IDs of billing
-	var billDetailsId []string
-	err = client.Get(fmt.Sprintf("/me/bill/%s/details", billId), &billDetailsId)
+	cache, err := getBillingCache(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", "cache_error", err)
+		return nil, err
+	}
+
+	// First, we get IDs of billing, they never change once the bill is issued
+	billDetailsId, err := getCached[[]string](ctx, client, cache, fmt.Sprintf("/me/bill/%s/details", billId))
 
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", err)
 		return nil, err
 	}
 
-	for _, id := range billDetailsId {
-		d.StreamListItem(ctx, BillDetail{
-			ID:     id,
-			BillID: billId,
-		})
+	err = getBatchedCached(ctx, d, client, cache, fmt.Sprintf("/me/bill/%s/details", billId), billDetailsId, func(billDetail BillDetail) {
+		billDetail.BillID = billId
+		d.StreamListItem(ctx, billDetail)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", err)
+		return nil, err
 	}
 
 	return nil, nil
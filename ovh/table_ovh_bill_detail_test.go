@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestBillDetailList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_bill_detail"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_bill_detail", Quals: []ovhtest.Qual{ovhtest.Equals("bill_id", "FR0001")}}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":           "D1",
+		"bill_id":      "FR0001",
+		"description":  "Public Cloud",
+		"domain":       "p1",
+		"period_start": mustParseTime(t, "2024-01-01T00:00:00Z"),
+		"period_end":   mustParseTime(t, "2024-01-31T00:00:00Z"),
+		"quantity":     "1",
+		"total_price":  10.0,
+		"unit_price":   10.0,
+	})
+	checkRow(t, rows[1], ovhtest.Row{"id": "D2", "period_start": nil, "quantity": "2", "total_price": 14.0})
+}
+
+func TestBillDetailGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_bill_detail"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill_detail", Quals: []ovhtest.Qual{ovhtest.Equals("bill_id", "FR0001"), ovhtest.Equals("id", "D2")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "D2", "bill_id": "FR0001", "domain": "example.com", "unit_price": 7.0})
+}
+
+func TestBillDetailListMissingBill(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_bill_detail"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill_detail", Quals: []ovhtest.Qual{ovhtest.Equals("bill_id", "FR9999")}})
+
+	checkRowCount(t, rows, 0)
+}
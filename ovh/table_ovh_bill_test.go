@@ -0,0 +1,162 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestBillList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_bill"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_bill"}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":                "FR0001",
+		"date":              mustParseTime(t, "2024-01-01T00:00:00+01:00"),
+		"pdf_url":           "https://www.ovh.com/cgi-bin/order/facture.pdf?reference=FR0001",
+		"order_id":          int64(1001),
+		"category":          "autorenew",
+		"password":          "secret1",
+		"price_with_tax":    12.0,
+		"price_without_tax": 10.0,
+		"tax":               2.0,
+		"account":           testNichandle,
+	})
+	checkRow(t, rows[1], ovhtest.Row{"id": "FR0002", "category": "purchase"})
+}
+
+func TestBillGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_bill"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill", Quals: []ovhtest.Qual{ovhtest.Equals("id", "FR0002")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "FR0002", "order_id": int64(1002), "price_with_tax": 24.0})
+}
+
+func TestBillListDateRange(t *testing.T) {
+	tests := []struct {
+		quals    []ovhtest.Qual
+		request  string
+		expected string
+	}{
+		{
+			[]ovhtest.Qual{{Column: "date", Operator: ">=", Value: mustParseTime(t, "2024-01-15T00:00:00Z")}},
+			"GET /me/bill?date.from=2024-01-15T00%3A00%3A00Z",
+			"FR0002",
+		},
+		{
+			[]ovhtest.Qual{
+				{Column: "date", Operator: ">", Value: mustParseTime(t, "2023-11-01T00:00:00Z")},
+				{Column: "date", Operator: ">", Value: mustParseTime(t, "2023-12-01T00:00:00Z")},
+				{Column: "date", Operator: "<", Value: mustParseTime(t, "2024-01-01T00:00:00.5Z")},
+			},
+			"GET /me/bill?date.from=2023-12-01T00%3A00%3A00Z&date.to=2024-01-01T00%3A00%3A01Z",
+			"FR0001",
+		},
+	}
+	for _, test := range tests {
+		server, p := newTestPlugin(t, []string{"ovh_bill"})
+
+		rows := execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}, Quals: test.quals})
+
+		checkRowCount(t, rows, 1)
+		checkRow(t, rows[0], ovhtest.Row{"id": test.expected})
+		// the first request validates the consumer key
+		if requests := server.Requests(); len(requests) < 2 || requests[1] != test.request {
+			t.Errorf("expected the request %s, got %v", test.request, requests)
+		}
+	}
+}
+
+func TestBillListCache(t *testing.T) {
+	config := fmt.Sprintf("billing_cache_dir = %q", t.TempDir())
+	_, p := newTestPlugin(t, []string{"ovh_bill"}, config)
+	execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}})
+
+	// the bills are read from the cache, only the list of bills is fetched again
+	server, p := newTestPlugin(t, []string{"ovh_bill"}, config)
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id", "order_id"}}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{"id": "FR0001", "order_id": int64(1001)})
+	checkRow(t, rows[1], ovhtest.Row{"id": "FR0002", "order_id": int64(1002)})
+	expected := []string{"GET /auth/currentCredential", "GET /me/bill", "GET /me"}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected the requests %v, got %v", expected, requests)
+	}
+}
+
+func TestBillGetCache(t *testing.T) {
+	dir := t.TempDir()
+	config := fmt.Sprintf("billing_cache_dir = %q", dir)
+	_, p := newTestPlugin(t, []string{"ovh_bill"}, config)
+	execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}, Quals: []ovhtest.Qual{ovhtest.Equals("id", "FR0002")}})
+
+	if _, err := os.Stat(filepath.Join(dir, testNichandle, "me", "bill", "FR0002.json")); err != nil {
+		t.Fatalf("expected the bill in the cache: %s", err)
+	}
+
+	server, p := newTestPlugin(t, nil, config)
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id", "price_with_tax"}, Quals: []ovhtest.Qual{ovhtest.Equals("id", "FR0002")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "FR0002", "price_with_tax": 24.0})
+	for _, request := range server.Requests() {
+		if request == "GET /me/bill/FR0002" {
+			t.Errorf("unexpected request of a cached bill")
+		}
+	}
+}
+
+func TestBillCacheDownload(t *testing.T) {
+	dir := t.TempDir()
+	config := fmt.Sprintf("billing_cache_dir = %q", dir)
+	_, p := newTestPlugin(t, []string{"ovh_bill"}, config)
+	execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}, Quals: []ovhtest.Qual{ovhtest.Equals("id", "FR0002")}})
+
+	content, err := os.ReadFile(filepath.Join(dir, testNichandle, "me", "bill", "FR0002.json"))
+	if err != nil {
+		t.Fatalf("expected the bill in the cache: %s", err)
+	}
+	for _, field := range []string{"password", "pdfUrl", "url", "secret2"} {
+		if strings.Contains(string(content), field) {
+			t.Errorf("unexpected %s in the cached bill: %s", field, content)
+		}
+	}
+
+	// the download links and password of a cached bill are fetched from the API
+	server, p := newTestPlugin(t, []string{"ovh_bill"}, config)
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id", "password", "pdf_url"}, Quals: []ovhtest.Qual{ovhtest.Equals("id", "FR0002")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "FR0002", "password": "secret2", "pdf_url": "https://www.ovh.com/cgi-bin/order/facture.pdf?reference=FR0002"})
+	if requests := server.Requests(); !slices.Contains(requests, "GET /me/bill/FR0002") {
+		t.Errorf("expected the request of the bill, got %v", requests)
+	}
+}
+
+func TestBillingCacheFilename(t *testing.T) {
+	cache := &billingCache{dir: "cache"}
+	tests := map[string]string{
+		"/me/bill/FR0001":            filepath.Join("cache", "me", "bill", "FR0001.json"),
+		"/me/bill/FR0001/details":    filepath.Join("cache", "me", "bill", "FR0001", "details.json"),
+		"/me/refund/A%2FB/details/1": filepath.Join("cache", "me", "refund", "A%252FB", "details", "1.json"),
+		"/me/bill/../secret":         "",
+		"/me/bill//details":          "",
+	}
+	for path, expected := range tests {
+		filename, ok := cache.filename(path)
+		if ok != (expected != "") || filename != expected {
+			t.Errorf("%s: expected the file %q, got %q", path, expected, filename)
+		}
+	}
+}
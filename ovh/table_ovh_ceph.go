@@ -32,6 +32,13 @@ type Ceph struct {
 	UpdateDate     string            `json:"updateDate"`
 }
 
+func (ceph Ceph) urn(b urnBuilder) string {
+	if ceph.Iam.URN != "" {
+		return ceph.Iam.URN
+	}
+	return b.resource("dedicatedCeph", ceph.ID)
+}
+
 func tableOvhCeph() *plugin.Table {
 	return &plugin.Table{
 		Name:        "ovh_ceph",
@@ -42,11 +49,9 @@ func tableOvhCeph() *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"id"}),
 			Hydrate:    getCeph,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getCephInfo},
-		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -78,61 +83,50 @@ func tableOvhCeph() *plugin.Table {
 			},
 			{
 				Name:        "service_name",
-				Hydrate:     getCephInfo,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ServiceName"),
 				Description: "Ceph Name.",
 			},
 			{
 				Name:        "region",
-				Hydrate:     getCephInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Region where the Ceph cluster is located.",
 				Transform:   transform.FromField("Region"),
 			},
 			{
 				Name:        "size",
-				Hydrate:     getCephInfo,
 				Type:        proto.ColumnType_INT,
 				Description: "Size of the Ceph cluster in TB.",
 				Transform:   transform.FromField("Size"),
 			},
 			{
 				Name:        "state",
-				Hydrate:     getCephInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "State of the Ceph cluster.",
 				Transform:   transform.FromField("State"),
 			},
 			{
 				Name:        "status",
-				Hydrate:     getCephInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Status of the Ceph cluster.",
 				Transform:   transform.FromField("Status"),
 			},
 
-		},
-	}
-}
-
-func getCephInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	ceph := h.Item.(Ceph)
-
-	client, err := connect(ctx, d)
-	if err != nil {
-		plugin.Logger(ctx).Error("ovh_ceph.getCephInfo", "connection_error", err)
-		return nil, err
-	}
-
-	err = client.Get(fmt.Sprintf("/dedicated/ceph/%s", ceph.ID), &ceph)
-
-	if err != nil {
-		plugin.Logger(ctx).Error("ovh_ceph.getCephInfo", err)
-		return nil, err
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceName"),
+				Description: "Title of the resource.",
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Iam.Tags"),
+				Description: "A map of tags for the resource.",
+			},
+		}),
 	}
-
-	return ceph, nil
 }
 
 func listCeph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -150,18 +144,31 @@ func listCeph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		return nil, err
 	}
 
-	for _, cephId := range cephsId {
-		var ceph Ceph
-		ceph.ID = cephId
+	err = getBatched(ctx, d, client, "/dedicated/ceph", cephsId, func(ceph Ceph) {
 		d.StreamListItem(ctx, ceph)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ceph.listCeph", err)
+		return nil, err
 	}
 
 	return nil, nil
 }
 
 func getCeph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ceph.getCeph", "connection_error", err)
+		return nil, err
+	}
+
 	id := d.EqualsQuals["id"].GetStringValue()
 	var ceph Ceph
-	ceph.ID = id
+	err = client.Get(fmt.Sprintf("/dedicated/ceph/%s", id), &ceph)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ceph.getCeph", err)
+		return nil, err
+	}
+
 	return ceph, nil
 }
@@ -0,0 +1,34 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCephList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_ceph"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_ceph"})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":           "ceph-1",
+		"ceph_mons":    []interface{}{"10.0.0.1", "10.0.0.2"},
+		"ceph_version": "17.2",
+		"service_name": "ceph-1",
+		"region":       "GRA",
+		"size":         int64(10),
+		"state":        "ACTIVE",
+		"status":       "INSTALLED",
+	})
+}
+
+func TestCephGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_ceph"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_ceph", Quals: []ovhtest.Qual{ovhtest.Equals("id", "ceph-1")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "ceph-1", "ceph_version": "17.2"})
+}
@@ -15,18 +15,20 @@ func tableOvhCloudAIApp() *plugin.Table {
 		Name:        "ovh_cloud_ai_app",
 		Description: "OVHcloud AI Deploy lets you easily deploy machine learning models and applications to production, create your API access points effortlessly, and make effective predictions.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listAIApp,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listAIApp,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getAIApp,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getAIApp,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -75,7 +77,15 @@ func tableOvhCloudAIApp() *plugin.Table {
 				Transform:   transform.FromField("Status.URL"),
 				Description: "Access URL of the app.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Spec.Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -84,6 +94,11 @@ type AIApp struct {
 	CreatedAt time.Time   `json:"createdAt"`
 	Spec      AIAppSpec   `json:"spec"`
 	Status    AIAppStatus `json:"status"`
+	ProjectID string      `json:"-"`
+}
+
+func (app AIApp) urn(b urnBuilder) string {
+	return b.projectResource(app.ProjectID, "ai/app/"+app.ID)
 }
 
 type AIAppSpec struct {
@@ -98,31 +113,34 @@ type AIAppStatus struct {
 	AvailableReplicas int    `json:"availableReplicas"`
 }
 
-func getAIApp(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	app := h.Item.(AIApp)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
+func getAIApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.getAIApp", "connection_error", err)
 		return nil, err
 	}
-
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/app/%s", projectId, app.ID), &app)
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var app AIApp
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/app/%s", projectId, id), &app)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.getAIApp", err)
 		return nil, err
 	}
+	app.ProjectID = projectId
 	return app, nil
 }
 
-func listAIApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listAIApp(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.listAIApp", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var apps []AIApp
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/app", projectId), &apps)
 	if err != nil {
@@ -130,6 +148,7 @@ func listAIApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		return nil, err
 	}
 	for _, app := range apps {
+		app.ProjectID = projectId
 		d.StreamListItem(ctx, app)
 	}
 	return nil, nil
@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudAIAppList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ai_app"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ai_app", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id": "p1",
+		"id":         "app-1",
+		"name":       "demo",
+		"region":     "GRA",
+		"image":      "ovhcom/ai-training-pytorch",
+		"created_at": mustParseTime(t, "2024-01-01T00:00:00Z"),
+		"state":      "RUNNING",
+		"replicas":   int64(2),
+		"url":        "https://app-1.app.gra.ai.cloud.ovh.net",
+	})
+}
+
+func TestCloudAIAppGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ai_app"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ai_app", Quals: append(projectQual(), ovhtest.Equals("id", "app-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "app-1", "project_id": "p1", "state": "RUNNING"})
+}
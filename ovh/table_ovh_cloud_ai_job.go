@@ -15,18 +15,20 @@ func tableOvhCloudAIJob() *plugin.Table {
 		Name:        "ovh_cloud_ai_job",
 		Description: "OVHcloud AI Training lets you train your AI, machine learning and deep learning models efficiently and easily, and optimise your GPU usage.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listAIJob,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listAIJob,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getAIJob,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getAIJob,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -69,7 +71,15 @@ func tableOvhCloudAIJob() *plugin.Table {
 				Transform:   transform.FromField("Status.URL"),
 				Description: "Access URL of the job.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Spec.Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -78,6 +88,11 @@ type AIJob struct {
 	CreatedAt time.Time   `json:"createdAt"`
 	Spec      AIJobSpec   `json:"spec"`
 	Status    AIJobStatus `json:"status"`
+	ProjectID string      `json:"-"`
+}
+
+func (job AIJob) urn(b urnBuilder) string {
+	return b.projectResource(job.ProjectID, "ai/job/"+job.ID)
 }
 
 type AIJobSpec struct {
@@ -91,31 +106,34 @@ type AIJobStatus struct {
 	State string `json:"state"`
 }
 
-func getAIJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	job := h.Item.(AIJob)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
+func getAIJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.getAIJob", "connection_error", err)
 		return nil, err
 	}
-
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/job/%s", projectId, job.ID), &job)
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var job AIJob
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/job/%s", projectId, id), &job)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.getAIJob", err)
 		return nil, err
 	}
+	job.ProjectID = projectId
 	return job, nil
 }
 
-func listAIJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listAIJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.listAIJob", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var jobs []AIJob
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/job", projectId), &jobs)
 	if err != nil {
@@ -123,6 +141,7 @@ func listAIJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		return nil, err
 	}
 	for _, job := range jobs {
+		job.ProjectID = projectId
 		d.StreamListItem(ctx, job)
 	}
 	return nil, nil
@@ -0,0 +1,34 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudAIJobList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ai_job"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ai_job", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id": "p1",
+		"id":         "job-1",
+		"name":       "training",
+		"region":     "GRA",
+		"image":      "ovhcom/ai-training-tensorflow",
+		"created_at": mustParseTime(t, "2024-01-02T00:00:00Z"),
+		"state":      "DONE",
+		"url":        "https://job-1.job.gra.ai.cloud.ovh.net",
+	})
+}
+
+func TestCloudAIJobGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ai_job"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ai_job", Quals: append(projectQual(), ovhtest.Equals("id", "job-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "job-1", "project_id": "p1", "state": "DONE"})
+}
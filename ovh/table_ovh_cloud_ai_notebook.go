@@ -15,18 +15,20 @@ func tableOvhCloudAINotebook() *plugin.Table {
 		Name:        "ovh_cloud_ai_notebook",
 		Description: "OVHcloud AI Notebook gives a quick and simple start launching your Jupyter or VS Code notebooks in the cloud.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listAINotebook,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listAINotebook,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getAINotebook,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getAINotebook,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -81,7 +83,15 @@ func tableOvhCloudAINotebook() *plugin.Table {
 				Transform:   transform.FromField("Status.URL"),
 				Description: "Access URL of the notebook.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Spec.Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -90,6 +100,11 @@ type AINotebook struct {
 	CreatedAt time.Time        `json:"createdAt"`
 	Spec      AINotebookSpec   `json:"spec"`
 	Status    AINotebookStatus `json:"status"`
+	ProjectID string           `json:"-"`
+}
+
+func (notebook AINotebook) urn(b urnBuilder) string {
+	return b.projectResource(notebook.ProjectID, "ai/notebook/"+notebook.ID)
 }
 
 type AINotebookSpec struct {
@@ -109,31 +124,34 @@ type AINotebookStatus struct {
 	State string `json:"state"`
 }
 
-func getAINotebook(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	notebook := h.Item.(AINotebook)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
+func getAINotebook(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.getAINotebook", "connection_error", err)
 		return nil, err
 	}
-
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", projectId, notebook.ID), &notebook)
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var notebook AINotebook
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", projectId, id), &notebook)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.getAINotebook", err)
 		return nil, err
 	}
+	notebook.ProjectID = projectId
 	return notebook, nil
 }
 
-func listAINotebook(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listAINotebook(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.listAINotebook", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var notebooks []AINotebook
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook", projectId), &notebooks)
 	if err != nil {
@@ -141,6 +159,7 @@ func listAINotebook(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		return nil, err
 	}
 	for _, notebook := range notebooks {
+		notebook.ProjectID = projectId
 		d.StreamListItem(ctx, notebook)
 	}
 	return nil, nil
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudAINotebookList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ai_notebook"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ai_notebook", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id": "p1",
+		"id":         "nb-1",
+		"name":       "notebook",
+		"region":     "BHS",
+		"framework":  "pytorch",
+		"version":    "2.1",
+		"editor":     "jupyterlab",
+		"created_at": mustParseTime(t, "2024-01-03T00:00:00Z"),
+		"state":      "RUNNING",
+		"url":        "https://nb-1.notebook.bhs.ai.cloud.ovh.net",
+	})
+}
+
+func TestCloudAINotebookGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ai_notebook"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ai_notebook", Quals: append(projectQual(), ovhtest.Equals("id", "nb-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "nb-1", "project_id": "p1", "editor": "jupyterlab"})
+}
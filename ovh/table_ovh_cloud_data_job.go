@@ -15,21 +15,23 @@ func tableOvhCloudDataJob() *plugin.Table {
 		Name:        "ovh_cloud_data_job",
 		Description: "A data job is processed by OVH by Apache Spark.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listDataJob,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listDataJob,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getDataJob,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getDataJob,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getDataJobInfo},
+			{Func: getDataJobInfo, IgnoreConfig: notFoundIgnoreConfig()},
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -100,7 +102,16 @@ func tableOvhCloudDataJob() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Maximum 'Time To Live' (in RFC3339 (duration)) of this job, after which it will be automatically terminated.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataJobInfo,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -116,11 +127,15 @@ type Job struct {
 	CreationDate  time.Time `json:"creationDate"`
 	Status        string    `json:"status"`
 	TTL           string    `json:"ttl"`
+	ProjectID     string    `json:"-"`
+}
+
+func (job Job) urn(b urnBuilder) string {
+	return b.projectResource(job.ProjectID, "dataProcessing/jobs/"+job.ID)
 }
 
 func getDataJobInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	job := h.Item.(Job)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -128,7 +143,7 @@ func getDataJobInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", projectId, job.ID), &job)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", job.ProjectID, job.ID), &job)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_data_job.getDataJobInfo", err)
 		return nil, err
@@ -136,13 +151,13 @@ func getDataJobInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	return job, nil
 }
 
-func listDataJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listDataJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_data_job.listDataJobInfo", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var jobIds []string
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs", projectId), &jobIds)
 	if err != nil {
@@ -152,14 +167,20 @@ func listDataJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	for _, jobId := range jobIds {
 		var job Job
 		job.ID = jobId
+		job.ProjectID = projectId
 		d.StreamListItem(ctx, job)
 	}
 	return nil, nil
 }
 
 func getDataJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
 	id := d.EqualsQuals["id"].GetStringValue()
+	if !projectIncluded(d, projectId) {
+		return nil, nil
+	}
 	var job Job
 	job.ID = id
+	job.ProjectID = projectId
 	return job, nil
 }
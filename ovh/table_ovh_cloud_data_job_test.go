@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudDataJobList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_data_job"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_data_job", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":     "p1",
+		"id":             "dj-1",
+		"name":           "wordcount",
+		"region":         "GRA",
+		"container_name": "spark",
+		"engine":         "spark",
+		"engine_version": "3.3",
+		"started_at":     mustParseTime(t, "2024-01-04T10:00:00Z"),
+		"ended_at":       mustParseTime(t, "2024-01-04T10:05:00Z"),
+		"created_at":     mustParseTime(t, "2024-01-04T09:59:00Z"),
+		"status":         "COMPLETED",
+		"ttl":            "2024-02-04T10:05:00Z",
+	})
+}
+
+func TestCloudDataJobGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_data_job"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_data_job", Quals: append(projectQual(), ovhtest.Equals("id", "dj-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "dj-1", "project_id": "p1", "status": "COMPLETED"})
+}
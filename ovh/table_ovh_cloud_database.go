@@ -15,18 +15,20 @@ func tableOvhCloudDatabase() *plugin.Table {
 		Name:        "ovh_cloud_database",
 		Description: "An hosted database service.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listDatabase,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listDatabase,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getDatabase,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getDatabase,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -36,71 +38,68 @@ func tableOvhCloudDatabase() *plugin.Table {
 			},
 			{
 				Name:        "engine",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Name of the engine of the service.",
 			},
 			{
 				Name:        "plan",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Plan of the cluster.",
 			},
 			{
 				Name:        "created_at",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Date of the creation of the cluster.",
 			},
 			{
 				Name:        "status",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Current status of the cluster.",
 			},
 			{
 				Name:        "node_number",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Number of nodes in the cluster.",
 			},
 			{
 				Name:        "description",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Description of the cluster.",
 			},
 			{
 				Name:        "version",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Version of the engine deployed on the cluster.",
 			},
 			{
 				Name:        "network_type",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Type of network of the cluster.",
 			},
 			{
 				Name:        "flavor",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "The VM flavor used for this cluster.",
 			},
 			{
 				Name:        "backup_time",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Time on which backups start every day.",
 			},
 			{
 				Name:        "maintenance_time",
-				Hydrate:     getDatabaseInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Time on which maintenances can start every day.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 
 }
@@ -118,50 +117,55 @@ type Database struct {
 	Flavor          string     `json:"flavor"`
 	BackupTime      string     `json:"backupTime"`
 	MaintenanceTime string     `json:"maintenanceTime"`
+	ProjectID       string     `json:"-"`
 }
 
-func getDatabaseInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	database := h.Item.(Database)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
-	client, err := connect(ctx, d)
-	if err != nil {
-		plugin.Logger(ctx).Error("ovh_cloud_database.getDatabaseInfo", "connection_error", err)
-		return nil, err
-	}
-
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/service/%s", projectId, database.ID), &database)
-	if err != nil {
-		plugin.Logger(ctx).Error("ovh_cloud_database.getDatabaseInfo", err)
-		return nil, err
-	}
-	return database, nil
+func (database Database) urn(b urnBuilder) string {
+	return b.projectResource(database.ProjectID, "database/service/"+database.ID)
 }
 
-func listDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listDatabase(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_database.listDatabaseInfo", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var databaseIds []string
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/service", projectId), &databaseIds)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_database.listDatabaseInfo", err)
 		return nil, err
 	}
-	for _, databaseId := range databaseIds {
-		var database Database
-		database.ID = databaseId
+	err = getBatched(ctx, d, client, fmt.Sprintf("/cloud/project/%s/database/service", projectId), databaseIds, func(database Database) {
+		database.ProjectID = projectId
 		d.StreamListItem(ctx, database)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_database.listDatabaseInfo", err)
+		return nil, err
 	}
 	return nil, nil
 }
 
 func getDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_database.getDatabase", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
 	id := d.EqualsQuals["id"].GetStringValue()
 	var database Database
-	database.ID = id
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/service/%s", projectId, id), &database)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_database.getDatabase", err)
+		return nil, err
+	}
+	database.ProjectID = projectId
 	return database, nil
 }
@@ -0,0 +1,40 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudDatabaseList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_database"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_database", Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":       "p1",
+		"id":               "db-1",
+		"engine":           "mongodb",
+		"plan":             "business",
+		"created_at":       mustParseTime(t, "2024-01-05T00:00:00Z"),
+		"status":           "READY",
+		"node_number":      "3",
+		"description":      "main database",
+		"version":          "6.0",
+		"network_type":     "private",
+		"flavor":           "db1-7",
+		"backup_time":      "02:00:00",
+		"maintenance_time": "03:00:00",
+	})
+	checkRow(t, rows[1], ovhtest.Row{"id": "db-2", "engine": "redis", "node_number": "1"})
+}
+
+func TestCloudDatabaseGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_database"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_database", Quals: append(projectQual(), ovhtest.Equals("id", "db-2"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "db-2", "project_id": "p1", "description": "cache"})
+}
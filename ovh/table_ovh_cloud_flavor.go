@@ -14,18 +14,20 @@ func tableOvhCloudFlavor() *plugin.Table {
 		Name:        "ovh_cloud_flavor",
 		Description: "A flavor is the instance model defining its characteristics in terms of resources.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listFlavor,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:       listFlavor,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getFlavor,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getFlavor,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -103,7 +105,15 @@ func tableOvhCloudFlavor() *plugin.Table {
 				Description: "Plan code to order hourly instance",
 				Transform:   transform.FromField("PlanCodes.Hourly"),
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -126,28 +136,37 @@ type Flavor struct {
 	Available         bool      `json:"available"`
 	Quota             int       `json:"quota"`
 	PlanCodes         PlanCodes `json:"planCodes"`
+	ProjectID         string    `json:"-"`
 }
 
-func listFlavor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func (flavor Flavor) urn(b urnBuilder) string {
+	return b.projectResource(flavor.ProjectID, "flavor/"+flavor.ID)
+}
+
+func listFlavor(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.listFlavor", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var flavors []Flavor
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/flavor", projectId), &flavors)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/flavor", projectId)+equalsQualsQuery(d, map[string]string{"region": "region"}), &flavors)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.listFlavor", err)
 		return nil, err
 	}
 	for _, flavor := range flavors {
+		flavor.ProjectID = projectId
 		d.StreamListItem(ctx, flavor)
 	}
 	return nil, nil
 }
 
 func getFlavor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.getFlavor", "connection_error", err)
@@ -161,5 +180,6 @@ func getFlavor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.getFlavor", err)
 		return nil, err
 	}
+	flavor.ProjectID = projectId
 	return flavor, nil
 }
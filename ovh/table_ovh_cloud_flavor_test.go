@@ -0,0 +1,39 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudFlavorList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_flavor"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_flavor", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":         "p1",
+		"id":                 "fl-1",
+		"name":               "b2-7",
+		"region":             "GRA11",
+		"ram":                int64(7000),
+		"disk":               int64(50),
+		"vcpus":              int64(2),
+		"type":               "ovh.ssd.eg",
+		"os_type":            "linux",
+		"inbound_bandwidth":  int64(250),
+		"outbound_bandwidth": int64(250),
+		"available":          true,
+		"quota":              int64(20),
+	})
+}
+
+func TestCloudFlavorGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_flavor"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_flavor", Quals: append(projectQual(), ovhtest.Equals("id", "fl-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "fl-1", "project_id": "p1", "name": "b2-7"})
+}
@@ -15,18 +15,20 @@ func tableOvhCloudImage() *plugin.Table {
 		Name:        "ovh_cloud_image",
 		Description: "An image is a pre-installed, ready-to-use operating system.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listImage,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "region", "type", "flavor_type"}),
+			Hydrate:       listImage,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getImage,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getImage,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -102,7 +104,14 @@ func tableOvhCloudImage() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Order plan code.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+		}),
 	}
 }
 
@@ -121,28 +130,37 @@ type Image struct {
 	FlavorType   string    `json:"flavorType"`
 	Tags         []string  `json:"tags"`
 	PlanCode     string    `json:"planCode"`
+	ProjectID    string    `json:"-"`
 }
 
-func listImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func (image Image) urn(b urnBuilder) string {
+	return b.projectResource(image.ProjectID, "image/"+image.ID)
+}
+
+func listImage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_image.listImage", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var images []Image
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/image", projectId), &images)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/image", projectId)+equalsQualsQuery(d, map[string]string{"region": "region", "type": "osType", "flavor_type": "flavorType"}), &images)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_image.listImage", err)
 		return nil, err
 	}
 	for _, image := range images {
+		image.ProjectID = projectId
 		d.StreamListItem(ctx, image)
 	}
 	return nil, nil
 }
 
 func getImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_image.getImage", "connection_error", err)
@@ -156,5 +174,6 @@ func getImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		plugin.Logger(ctx).Error("ovh_cloud_image.getImage", err)
 		return nil, err
 	}
+	image.ProjectID = projectId
 	return image, nil
 }
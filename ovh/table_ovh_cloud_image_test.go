@@ -0,0 +1,56 @@
+package ovh
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudImageList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_image"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_image", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":  "p1",
+		"id":          "im-1",
+		"name":        "Debian 12",
+		"region":      "GRA11",
+		"visibility":  "public",
+		"type":        "linux",
+		"created_at":  mustParseTime(t, "2024-01-06T00:00:00Z"),
+		"status":      "active",
+		"user":        "debian",
+		"flavor_type": nil,
+		"tags":        []interface{}{"debian"},
+		"plan_code":   "image.consumption",
+	})
+}
+
+func TestCloudImageGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_image"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_image", Quals: append(projectQual(), ovhtest.Equals("id", "im-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "im-1", "project_id": "p1", "user": "debian"})
+}
+
+func TestCloudImageListFilters(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_image"})
+
+	rows := execute(t, p, ovhtest.Query{
+		Table:   "ovh_cloud_image",
+		Columns: []string{"id"},
+		Quals:   append(projectQual(), ovhtest.Equals("region", "GRA11"), ovhtest.Equals("type", "windows"), ovhtest.Equals("flavor_type", "win-b2-7")),
+	})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "im-2"})
+	expected := "GET /cloud/project/p1/image?flavorType=win-b2-7&osType=windows&region=GRA11"
+	if requests := server.Requests(); !slices.Contains(requests, expected) {
+		t.Errorf("expected the request %s, got %v", expected, requests)
+	}
+}
@@ -15,18 +15,20 @@ func tableOvhCloudInstance() *plugin.Table {
 		Name:        "ovh_cloud_instance",
 		Description: "An instance is a virtual server in the OVH cloud.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listInstance,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:       listInstance,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getInstance,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getInstance,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -80,7 +82,15 @@ func tableOvhCloudInstance() *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Description: "Instance outgoing network traffic for the current month (in bytes).",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -98,28 +108,37 @@ type Instance struct {
 	Status                      string    `json:"status"`
 	PlanCode                    string    `json:"planCode"`
 	CurrentMonthOutgoingTraffic *int      `json:"currentMonthOutgoingTraffic,omitempty"`
+	ProjectID                   string    `json:"-"`
 }
 
-func listInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func (instance Instance) urn(b urnBuilder) string {
+	return b.projectResource(instance.ProjectID, "instance/"+instance.ID)
+}
+
+func listInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_instance.listInstance", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var instances []Instance
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/instance", projectId), &instances)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/instance", projectId)+equalsQualsQuery(d, map[string]string{"region": "region"}), &instances)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_instance.listInstance", err)
 		return nil, err
 	}
 	for _, instance := range instances {
+		instance.ProjectID = projectId
 		d.StreamListItem(ctx, instance)
 	}
 	return nil, nil
 }
 
 func getInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_instance.getInstance", "connection_error", err)
@@ -136,5 +155,6 @@ func getInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	instance.ImageID = instance.Image.ID
 	instance.FlavorID = instance.Flavor.ID
 	instance.SSHKeyID = instance.SSHKey.ID
+	instance.ProjectID = projectId
 	return instance, nil
 }
@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudInstanceList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_instance"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_instance", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":                     "p1",
+		"id":                             "in-1",
+		"name":                           "web-1",
+		"flavor_id":                      "fl-1",
+		"image_id":                       "im-1",
+		"ssh_key_id":                     "sk-1",
+		"created_at":                     mustParseTime(t, "2024-01-07T00:00:00Z"),
+		"region":                         "GRA11",
+		"status":                         "ACTIVE",
+		"plan_code":                      "b2-7.consumption",
+		"current_month_outgoing_traffic": int64(1024),
+	})
+}
+
+func TestCloudInstanceGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_instance"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_instance", Quals: append(projectQual(), ovhtest.Equals("id", "in-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "in-1", "project_id": "p1", "name": "web-1"})
+}
@@ -0,0 +1,230 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudKube() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube",
+		Description: "A managed Kubernetes cluster.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listKube,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getKube,
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Cluster ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the cluster.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the cluster.",
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kubernetes version of the cluster.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the cluster.",
+			},
+			{
+				Name:        "update_policy",
+				Type:        proto.ColumnType_STRING,
+				Description: "Policy of the updates of the cluster (ALWAYS_UPDATE, MINIMAL_DOWNTIME or NEVER_UPDATE).",
+			},
+			{
+				Name:        "is_up_to_date",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsUpToDate"),
+				Description: "True if all the nodes and the control plane are up to date.",
+			},
+			{
+				Name:        "control_plane_is_up_to_date",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ControlPlaneIsUpToDate"),
+				Description: "True if the control plane is up to date.",
+			},
+			{
+				Name:        "next_upgrade_versions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Kubernetes versions available for an upgrade.",
+			},
+			{
+				Name:        "private_network_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateNetworkID").NullIfZero(),
+				Description: "OpenStack ID of the private network of the cluster, null if the cluster is on the public network.",
+			},
+			{
+				Name:        "nodes_subnet_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodesSubnetID").NullIfZero(),
+				Description: "OpenStack ID of the subnet of the nodes.",
+			},
+			{
+				Name:        "load_balancers_subnet_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancersSubnetID").NullIfZero(),
+				Description: "OpenStack ID of the subnet of the load balancers.",
+			},
+			{
+				Name:        "private_network_configuration",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of the private network of the cluster.",
+			},
+			{
+				Name:        "kube_proxy_mode",
+				Type:        proto.ColumnType_STRING,
+				Description: "Mode of kube-proxy (iptables or ipvs).",
+			},
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+				Description: "URL of the API server of the cluster.",
+			},
+			{
+				Name:        "nodes_url",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodesURL"),
+				Description: "Domain of the nodes of the cluster.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Creation date of the cluster.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the cluster.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type Kube struct {
+	ID                          string          `json:"id"`
+	Name                        string          `json:"name"`
+	Region                      string          `json:"region"`
+	Version                     string          `json:"version"`
+	Status                      string          `json:"status"`
+	UpdatePolicy                string          `json:"updatePolicy"`
+	IsUpToDate                  bool            `json:"isUpToDate"`
+	ControlPlaneIsUpToDate      bool            `json:"controlPlaneIsUpToDate"`
+	NextUpgradeVersions         []string        `json:"nextUpgradeVersions"`
+	PrivateNetworkID            string          `json:"privateNetworkId"`
+	NodesSubnetID               string          `json:"nodesSubnetId"`
+	LoadBalancersSubnetID       string          `json:"loadBalancersSubnetId"`
+	PrivateNetworkConfiguration *KubeNetworking `json:"privateNetworkConfiguration"`
+	KubeProxyMode               string          `json:"kubeProxyMode"`
+	URL                         string          `json:"url"`
+	NodesURL                    string          `json:"nodesUrl"`
+	CreatedAt                   *time.Time      `json:"createdAt"`
+	UpdatedAt                   *time.Time      `json:"updatedAt"`
+	ProjectID                   string          `json:"-"`
+}
+
+type KubeNetworking struct {
+	DefaultVrackGateway            string `json:"defaultVrackGateway"`
+	PrivateNetworkRoutingAsDefault bool   `json:"privateNetworkRoutingAsDefault"`
+}
+
+func (kube Kube) urn(b urnBuilder) string {
+	return b.projectResource(kube.ProjectID, "kube/"+kube.ID)
+}
+
+func listKube(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube.listKube", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	var kubeIds []string
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube", projectId), &kubeIds)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube.listKube", err)
+		return nil, err
+	}
+	err = getBatched(ctx, d, client, fmt.Sprintf("/cloud/project/%s/kube", projectId), kubeIds, func(kube Kube) {
+		kube.ProjectID = projectId
+		d.StreamListItem(ctx, kube)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube.listKube", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getKube(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube.getKube", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var kube Kube
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s", projectId, id), &kube)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube.getKube", err)
+		return nil, err
+	}
+	kube.ProjectID = projectId
+	return kube, nil
+}
+
+// listKubeIds returns the cluster given in the kube_id qual, or every cluster of the project,
+// for the tables of the objects of a cluster.
+func listKubeIds(client *ovh.Client, d *plugin.QueryData, projectId string) ([]string, error) {
+	if kubeId := d.EqualsQuals["kube_id"].GetStringValue(); kubeId != "" {
+		return []string{kubeId}, nil
+	}
+	var kubeIds []string
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/kube", projectId), &kubeIds)
+	return kubeIds, err
+}
@@ -0,0 +1,237 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudKubeCustomization() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_customization",
+		Description: "The customization of the API server, of kube-proxy and the OpenID Connect configuration of a managed Kubernetes cluster.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "kube_id"}),
+			Hydrate:       listKubeCustomization,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "kube_id"}),
+			Hydrate:      getKubeCustomization,
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "kube_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "ID of the cluster.",
+			},
+			{
+				Name:        "admission_plugins_enabled",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("APIServer.AdmissionPlugins.Enabled"),
+				Description: "Admission plugins enabled on the API server.",
+			},
+			{
+				Name:        "admission_plugins_disabled",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("APIServer.AdmissionPlugins.Disabled"),
+				Description: "Admission plugins disabled on the API server.",
+			},
+			{
+				Name:        "kube_proxy_iptables",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("KubeProxy.Iptables"),
+				Description: "Customization of kube-proxy in iptables mode.",
+			},
+			{
+				Name:        "kube_proxy_ipvs",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("KubeProxy.Ipvs"),
+				Description: "Customization of kube-proxy in ipvs mode.",
+			},
+			{
+				Name:        "oidc_issuer_url",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("IssuerURL").NullIfZero(),
+				Description: "URL of the OpenID Connect provider, null if OpenID Connect is not configured.",
+			},
+			{
+				Name:        "oidc_client_id",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("ClientID").NullIfZero(),
+				Description: "Client ID of the OpenID Connect provider.",
+			},
+			{
+				Name:        "oidc_username_claim",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("UsernameClaim").NullIfZero(),
+				Description: "JWT claim used as the user name.",
+			},
+			{
+				Name:        "oidc_username_prefix",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("UsernamePrefix").NullIfZero(),
+				Description: "Prefix of the user names.",
+			},
+			{
+				Name:        "oidc_groups_claim",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("GroupsClaim"),
+				Description: "JWT claims used as the groups of the user.",
+			},
+			{
+				Name:        "oidc_groups_prefix",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("GroupsPrefix").NullIfZero(),
+				Description: "Prefix of the groups.",
+			},
+			{
+				Name:        "oidc_required_claim",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("RequiredClaim"),
+				Description: "Claims required in the ID token, as key=value.",
+			},
+			{
+				Name:        "oidc_signing_algorithms",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKubeOpenIdConnect,
+				Transform:   transform.FromField("SigningAlgorithms"),
+				Description: "Signing algorithms accepted for the ID tokens.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type KubeCustomization struct {
+	APIServer struct {
+		AdmissionPlugins struct {
+			Enabled  []string `json:"enabled"`
+			Disabled []string `json:"disabled"`
+		} `json:"admissionPlugins"`
+	} `json:"apiServer"`
+	KubeProxy struct {
+		Iptables map[string]interface{} `json:"iptables"`
+		Ipvs     map[string]interface{} `json:"ipvs"`
+	} `json:"kubeProxy"`
+	ProjectID string `json:"-"`
+	KubeID    string `json:"-"`
+}
+
+type KubeOpenIdConnect struct {
+	IssuerURL         string   `json:"issuerUrl"`
+	ClientID          string   `json:"clientId"`
+	UsernameClaim     string   `json:"usernameClaim"`
+	UsernamePrefix    string   `json:"usernamePrefix"`
+	GroupsClaim       []string `json:"groupsClaim"`
+	GroupsPrefix      string   `json:"groupsPrefix"`
+	RequiredClaim     []string `json:"requiredClaim"`
+	SigningAlgorithms []string `json:"signingAlgorithms"`
+}
+
+func (customization KubeCustomization) urn(b urnBuilder) string {
+	return b.projectResource(customization.ProjectID, "kube/"+customization.KubeID+"/customization")
+}
+
+func listKubeCustomization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_customization.listKubeCustomization", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	kubeIds, err := listKubeIds(client, d, projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_customization.listKubeCustomization", err)
+		return nil, err
+	}
+	for _, kubeId := range kubeIds {
+		var customization KubeCustomization
+		err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/customization", projectId, kubeId), &customization)
+		if err != nil {
+			if isNotFoundError(err) {
+				// the cluster was deleted since it was listed
+				continue
+			}
+			plugin.Logger(ctx).Error("ovh_cloud_kube_customization.listKubeCustomization", err)
+			return nil, err
+		}
+		customization.ProjectID = projectId
+		customization.KubeID = kubeId
+		d.StreamListItem(ctx, customization)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+func getKubeCustomization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_customization.getKubeCustomization", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	kubeId := d.EqualsQuals["kube_id"].GetStringValue()
+	var customization KubeCustomization
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/customization", projectId, kubeId), &customization)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_customization.getKubeCustomization", err)
+		return nil, err
+	}
+	customization.ProjectID = projectId
+	customization.KubeID = kubeId
+	return customization, nil
+}
+
+func getKubeOpenIdConnect(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	customization := h.Item.(KubeCustomization)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_customization.getKubeOpenIdConnect", "connection_error", err)
+		return nil, err
+	}
+
+	var openIdConnect KubeOpenIdConnect
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/openIdConnect", customization.ProjectID, customization.KubeID), &openIdConnect)
+	if err != nil {
+		// the clusters without OpenID Connect have no configuration
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("ovh_cloud_kube_customization.getKubeOpenIdConnect", err)
+		return nil, err
+	}
+	return openIdConnect, nil
+}
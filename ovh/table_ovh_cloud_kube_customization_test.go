@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudKubeCustomizationList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_customization"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_customization", Quals: projectQual()}), "kube_id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":                 "p1",
+		"kube_id":                    "kube-1",
+		"admission_plugins_enabled":  []interface{}{"NodeRestriction", "AlwaysPullImages"},
+		"admission_plugins_disabled": []interface{}{},
+		"kube_proxy_iptables":        map[string]interface{}{"minSyncPeriod": "PT1S", "syncPeriod": "PT30S"},
+		"kube_proxy_ipvs":            nil,
+		"oidc_issuer_url":            "https://sso.example.com",
+		"oidc_client_id":             "kubernetes",
+		"oidc_username_claim":        "email",
+		"oidc_username_prefix":       "oidc:",
+		"oidc_groups_claim":          []interface{}{"groups"},
+		"oidc_groups_prefix":         "oidc:",
+		"oidc_required_claim":        []interface{}{},
+		"oidc_signing_algorithms":    []interface{}{"RS256"},
+	})
+	// the OpenID Connect configuration of kube-2 is not found, it is not configured
+	checkRow(t, rows[1], ovhtest.Row{
+		"kube_id":                    "kube-2",
+		"admission_plugins_disabled": []interface{}{"AlwaysPullImages"},
+		"kube_proxy_iptables":        nil,
+		"oidc_issuer_url":            nil,
+		"oidc_client_id":             nil,
+	})
+}
+
+func TestCloudKubeCustomizationGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_customization"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_customization", Quals: append(projectQual(), ovhtest.Equals("kube_id", "kube-2"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":                "p1",
+		"kube_id":                   "kube-2",
+		"admission_plugins_enabled": []interface{}{"NodeRestriction"},
+		"akas":                      []interface{}{"urn:v1:eu:resource:publicCloudProject:p1/kube/kube-2/customization"},
+	})
+}
@@ -0,0 +1,140 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudKubeIpRestriction() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_ip_restriction",
+		Description: "An IP block allowed to reach the API server of a managed Kubernetes cluster.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "kube_id"}),
+			Hydrate:       listKubeIpRestriction,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "kube_id", "ip"}),
+			Hydrate:      getKubeIpRestriction,
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "kube_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "ID of the cluster.",
+			},
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IP"),
+				Description: "IP block allowed to reach the API server.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IP"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type KubeIpRestriction struct {
+	IP        string
+	ProjectID string
+	KubeID    string
+}
+
+func (restriction KubeIpRestriction) urn(b urnBuilder) string {
+	return b.projectResource(restriction.ProjectID, "kube/"+restriction.KubeID+"/ipRestrictions/"+restriction.IP)
+}
+
+func listKubeIpRestriction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_ip_restriction.listKubeIpRestriction", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	kubeIds, err := listKubeIds(client, d, projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_ip_restriction.listKubeIpRestriction", err)
+		return nil, err
+	}
+	for _, kubeId := range kubeIds {
+		var ips []string
+		err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", projectId, kubeId), &ips)
+		if err != nil {
+			if isNotFoundError(err) {
+				// the cluster was deleted since it was listed
+				continue
+			}
+			plugin.Logger(ctx).Error("ovh_cloud_kube_ip_restriction.listKubeIpRestriction", err)
+			return nil, err
+		}
+		for _, ip := range ips {
+			d.StreamListItem(ctx, KubeIpRestriction{IP: ipBlock(ip), ProjectID: projectId, KubeID: kubeId})
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func getKubeIpRestriction(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_ip_restriction.getKubeIpRestriction", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	kubeId := d.EqualsQuals["kube_id"].GetStringValue()
+	block := ipBlock(d.EqualsQualString("ip"))
+	// the API has no route to get a single IP restriction
+	var ips []string
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", projectId, kubeId), &ips)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_ip_restriction.getKubeIpRestriction", err)
+		return nil, err
+	}
+	for _, ip := range ips {
+		if ipBlock(ip) == block {
+			return KubeIpRestriction{IP: block, ProjectID: projectId, KubeID: kubeId}, nil
+		}
+	}
+	return nil, nil
+}
+
+// ipBlock returns the IP block of an IP address or block of the API, a single address
+// is a /32 (or /128) block.
+func ipBlock(ip string) string {
+	if strings.Contains(ip, "/") {
+		return ip
+	}
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+		return ip + "/128"
+	}
+	return ip + "/32"
+}
@@ -0,0 +1,32 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudKubeIpRestrictionList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_ip_restriction"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_ip_restriction", Quals: projectQual()}), "ip")
+
+	checkRowCount(t, rows, 4)
+	checkRow(t, rows[0], ovhtest.Row{"project_id": "p1", "kube_id": "kube-2", "ip": "0.0.0.0/0", "title": "0.0.0.0/0", "akas": []interface{}{"urn:v1:eu:resource:publicCloudProject:p1/kube/kube-2/ipRestrictions/0.0.0.0/0"}})
+	checkRow(t, rows[1], ovhtest.Row{"kube_id": "kube-1", "ip": "192.0.2.0/24"})
+	checkRow(t, rows[2], ovhtest.Row{"kube_id": "kube-1", "ip": "198.51.100.7/32"})
+	checkRow(t, rows[3], ovhtest.Row{"kube_id": "kube-1", "ip": "2001:db8::1/128"})
+}
+
+func TestCloudKubeIpRestrictionGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_ip_restriction"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_ip_restriction", Quals: append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("ip", "198.51.100.7/32"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"project_id": "p1", "kube_id": "kube-1", "ip": "198.51.100.7/32"})
+
+	rows = execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_ip_restriction", Quals: append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("ip", "203.0.113.0/24"))})
+
+	checkRowCount(t, rows, 0)
+}
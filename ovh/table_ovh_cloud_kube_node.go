@@ -0,0 +1,187 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudKubeNode() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_node",
+		Description: "A node of a managed Kubernetes cluster.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "kube_id"}),
+			Hydrate:       listKubeNode,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "kube_id", "id"}),
+			Hydrate:      getKubeNode,
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "kube_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "ID of the cluster of the node.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the node.",
+			},
+			{
+				Name:        "nodepool_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodepoolID"),
+				Description: "ID of the node pool of the node.",
+			},
+			{
+				Name:        "instance_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceID").NullIfZero(),
+				Description: "ID of the instance of the node, null until the instance is created.",
+			},
+			{
+				Name:        "flavor",
+				Type:        proto.ColumnType_STRING,
+				Description: "Flavor name of the node.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the node.",
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kubernetes version of the node.",
+			},
+			{
+				Name:        "is_up_to_date",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsUpToDate"),
+				Description: "True if the node is up to date.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Creation date of the node.",
+			},
+			{
+				Name:        "deployed_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Date of the deployment of the node in the cluster.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the node.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type KubeNode struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	NodepoolID string     `json:"nodePoolId"`
+	InstanceID string     `json:"instanceId"`
+	Flavor     string     `json:"flavor"`
+	Status     string     `json:"status"`
+	Version    string     `json:"version"`
+	IsUpToDate bool       `json:"isUpToDate"`
+	CreatedAt  *time.Time `json:"createdAt"`
+	DeployedAt *time.Time `json:"deployedAt"`
+	UpdatedAt  *time.Time `json:"updatedAt"`
+	ProjectID  string     `json:"-"`
+	KubeID     string     `json:"-"`
+}
+
+func (node KubeNode) urn(b urnBuilder) string {
+	return b.projectResource(node.ProjectID, "kube/"+node.KubeID+"/node/"+node.ID)
+}
+
+func listKubeNode(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.listKubeNode", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	kubeIds, err := listKubeIds(client, d, projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.listKubeNode", err)
+		return nil, err
+	}
+	for _, kubeId := range kubeIds {
+		var nodes []KubeNode
+		err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/node", projectId, kubeId), &nodes)
+		if err != nil {
+			if isNotFoundError(err) {
+				// the cluster was deleted since it was listed
+				continue
+			}
+			plugin.Logger(ctx).Error("ovh_cloud_kube_node.listKubeNode", err)
+			return nil, err
+		}
+		for _, node := range nodes {
+			node.ProjectID = projectId
+			node.KubeID = kubeId
+			d.StreamListItem(ctx, node)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func getKubeNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.getKubeNode", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	kubeId := d.EqualsQuals["kube_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var node KubeNode
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/node/%s", projectId, kubeId, id), &node)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.getKubeNode", err)
+		return nil, err
+	}
+	node.ProjectID = projectId
+	node.KubeID = kubeId
+	return node, nil
+}
@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudKubeNodeList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_node"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_node", Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":    "p1",
+		"kube_id":       "kube-1",
+		"id":            "node-1",
+		"name":          "default-node-a1b2c3",
+		"nodepool_id":   "np-1",
+		"instance_id":   "in-1",
+		"flavor":        "b2-7",
+		"status":        "READY",
+		"version":       "1.29.3",
+		"is_up_to_date": true,
+		"created_at":    mustParseTime(t, "2024-02-01T00:00:00Z"),
+		"deployed_at":   mustParseTime(t, "2024-02-01T00:05:00Z"),
+		"updated_at":    mustParseTime(t, "2024-03-01T00:00:00Z"),
+		"title":         "default-node-a1b2c3",
+	})
+	checkRow(t, rows[1], ovhtest.Row{
+		"id":            "node-2",
+		"instance_id":   nil,
+		"is_up_to_date": false,
+		"deployed_at":   nil,
+	})
+}
+
+func TestCloudKubeNodeListDeletedCluster(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_kube_node"})
+	server.Handle(http.MethodGet, "/cloud/project/p1/kube", http.StatusOK, []string{"kube-0", "kube-1"})
+	server.HandleError(http.MethodGet, "/cloud/project/p1/kube/kube-0/node", http.StatusNotFound, "This service does not exist")
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_node", Columns: []string{"id"}, Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{"id": "node-1"})
+	checkRow(t, rows[1], ovhtest.Row{"id": "node-2"})
+}
+
+func TestCloudKubeNodeGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_node"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_node", Quals: append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("id", "node-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "node-1", "project_id": "p1", "kube_id": "kube-1", "instance_id": "in-1"})
+}
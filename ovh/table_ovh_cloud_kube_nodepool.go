@@ -0,0 +1,283 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudKubeNodepool() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_nodepool",
+		Description: "A node pool of a managed Kubernetes cluster.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "kube_id"}),
+			Hydrate:       listKubeNodepool,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "kube_id", "id"}),
+			Hydrate:      getKubeNodepool,
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "kube_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "ID of the cluster of the node pool.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node pool ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the node pool.",
+			},
+			{
+				Name:        "flavor",
+				Type:        proto.ColumnType_STRING,
+				Description: "Flavor name of the nodes.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the node pool.",
+			},
+			{
+				Name:        "size_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the size of the node pool compared to its desired number of nodes.",
+			},
+			{
+				Name:        "desired_nodes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DesiredNodes"),
+				Description: "Desired number of nodes.",
+			},
+			{
+				Name:        "current_nodes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentNodes"),
+				Description: "Current number of nodes.",
+			},
+			{
+				Name:        "available_nodes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AvailableNodes"),
+				Description: "Number of nodes ready to run workloads.",
+			},
+			{
+				Name:        "up_to_date_nodes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("UpToDateNodes"),
+				Description: "Number of nodes with the latest version of the node pool.",
+			},
+			{
+				Name:        "min_nodes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MinNodes"),
+				Description: "Minimum number of nodes of the autoscaling.",
+			},
+			{
+				Name:        "max_nodes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MaxNodes"),
+				Description: "Maximum number of nodes of the autoscaling.",
+			},
+			{
+				Name:        "autoscale",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Autoscale"),
+				Description: "True if the number of nodes is scaled automatically between min_nodes and max_nodes.",
+			},
+			{
+				Name:        "autoscaling",
+				Type:        proto.ColumnType_JSON,
+				Description: "Settings of the autoscaler (scale down thresholds and delays).",
+			},
+			{
+				Name:        "anti_affinity",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AntiAffinity"),
+				Description: "True if the nodes are spread on different hypervisors.",
+			},
+			{
+				Name:        "monthly_billed",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MonthlyBilled"),
+				Description: "True if the nodes are billed monthly instead of hourly.",
+			},
+			{
+				Name:        "availability_zones",
+				Type:        proto.ColumnType_JSON,
+				Description: "Availability zones of the nodes.",
+			},
+			{
+				Name:        "template_labels",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Template.Metadata.Labels"),
+				Description: "Labels applied to the nodes.",
+			},
+			{
+				Name:        "template_annotations",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Template.Metadata.Annotations"),
+				Description: "Annotations applied to the nodes.",
+			},
+			{
+				Name:        "template_taints",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Template.Spec.Taints"),
+				Description: "Taints applied to the nodes.",
+			},
+			{
+				Name:        "template_unschedulable",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Template.Spec.Unschedulable"),
+				Description: "True if the nodes are created unschedulable.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Creation date of the node pool.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the node pool.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type KubeNodepool struct {
+	ID                string                   `json:"id"`
+	Name              string                   `json:"name"`
+	Flavor            string                   `json:"flavor"`
+	Status            string                   `json:"status"`
+	SizeStatus        string                   `json:"sizeStatus"`
+	DesiredNodes      int                      `json:"desiredNodes"`
+	CurrentNodes      int                      `json:"currentNodes"`
+	AvailableNodes    int                      `json:"availableNodes"`
+	UpToDateNodes     int                      `json:"upToDateNodes"`
+	MinNodes          int                      `json:"minNodes"`
+	MaxNodes          int                      `json:"maxNodes"`
+	Autoscale         bool                     `json:"autoscale"`
+	Autoscaling       *KubeNodepoolAutoscaling `json:"autoscaling"`
+	AntiAffinity      bool                     `json:"antiAffinity"`
+	MonthlyBilled     bool                     `json:"monthlyBilled"`
+	AvailabilityZones []string                 `json:"availabilityZones"`
+	Template          KubeNodepoolTemplate     `json:"template"`
+	CreatedAt         *time.Time               `json:"createdAt"`
+	UpdatedAt         *time.Time               `json:"updatedAt"`
+	ProjectID         string                   `json:"-"`
+	KubeID            string                   `json:"-"`
+}
+
+type KubeNodepoolAutoscaling struct {
+	ScaleDownUnneededTimeSeconds  int     `json:"scaleDownUnneededTimeSeconds"`
+	ScaleDownUnreadyTimeSeconds   int     `json:"scaleDownUnreadyTimeSeconds"`
+	ScaleDownUtilizationThreshold float64 `json:"scaleDownUtilizationThreshold"`
+}
+
+type KubeNodepoolTemplate struct {
+	Metadata struct {
+		Labels      map[string]string `json:"labels"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		Taints        []KubeTaint `json:"taints"`
+		Unschedulable bool        `json:"unschedulable"`
+	} `json:"spec"`
+}
+
+type KubeTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+func (nodepool KubeNodepool) urn(b urnBuilder) string {
+	return b.projectResource(nodepool.ProjectID, "kube/"+nodepool.KubeID+"/nodepool/"+nodepool.ID)
+}
+
+func listKubeNodepool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_nodepool.listKubeNodepool", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	kubeIds, err := listKubeIds(client, d, projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_nodepool.listKubeNodepool", err)
+		return nil, err
+	}
+	for _, kubeId := range kubeIds {
+		var nodepools []KubeNodepool
+		err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", projectId, kubeId), &nodepools)
+		if err != nil {
+			if isNotFoundError(err) {
+				// the cluster was deleted since it was listed
+				continue
+			}
+			plugin.Logger(ctx).Error("ovh_cloud_kube_nodepool.listKubeNodepool", err)
+			return nil, err
+		}
+		for _, nodepool := range nodepools {
+			nodepool.ProjectID = projectId
+			nodepool.KubeID = kubeId
+			d.StreamListItem(ctx, nodepool)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func getKubeNodepool(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_nodepool.getKubeNodepool", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	kubeId := d.EqualsQuals["kube_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var nodepool KubeNodepool
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", projectId, kubeId, id), &nodepool)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_nodepool.getKubeNodepool", err)
+		return nil, err
+	}
+	nodepool.ProjectID = projectId
+	nodepool.KubeID = kubeId
+	return nodepool, nil
+}
@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudKubeNodepoolList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_nodepool"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_nodepool", Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":       "p1",
+		"kube_id":          "kube-1",
+		"id":               "np-1",
+		"name":             "default",
+		"flavor":           "b2-7",
+		"status":           "READY",
+		"size_status":      "CAPACITY_OK",
+		"desired_nodes":    int64(3),
+		"current_nodes":    int64(3),
+		"available_nodes":  int64(3),
+		"up_to_date_nodes": int64(2),
+		"min_nodes":        int64(0),
+		"max_nodes":        int64(5),
+		"autoscale":        true,
+		"autoscaling": map[string]interface{}{
+			"scaleDownUnneededTimeSeconds":  float64(600),
+			"scaleDownUnreadyTimeSeconds":   float64(1200),
+			"scaleDownUtilizationThreshold": 0.5,
+		},
+		"anti_affinity":      false,
+		"monthly_billed":     true,
+		"availability_zones": []interface{}{"eu-west-par-a"},
+		"template_labels":    map[string]interface{}{"team": "infra"},
+		"template_taints": []interface{}{
+			map[string]interface{}{"key": "dedicated", "value": "infra", "effect": "NoSchedule"},
+		},
+		"template_unschedulable": false,
+		"created_at":             mustParseTime(t, "2024-02-01T00:00:00Z"),
+		"title":                  "default",
+	})
+	checkRow(t, rows[1], ovhtest.Row{
+		"kube_id":         "kube-2",
+		"id":              "np-2",
+		"flavor":          "t1-45",
+		"autoscale":       false,
+		"anti_affinity":   true,
+		"template_labels": nil,
+		"template_taints": nil,
+	})
+}
+
+func TestCloudKubeNodepoolListCluster(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_kube_nodepool"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_nodepool", Columns: []string{"id"}, Quals: append(projectQual(), ovhtest.Equals("kube_id", "kube-2"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "np-2"})
+	for _, request := range server.Requests() {
+		if request == "GET /cloud/project/p1/kube" {
+			t.Errorf("expected the clusters not to be listed, got %v", server.Requests())
+		}
+	}
+}
+
+func TestCloudKubeNodepoolListDeletedCluster(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_kube_nodepool"})
+	server.HandleError(http.MethodGet, "/cloud/project/p1/kube/kube-1/nodepool", http.StatusNotFound, "This service does not exist")
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_nodepool", Columns: []string{"id"}, Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "np-2"})
+}
+
+func TestCloudKubeNodepoolGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube_nodepool"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube_nodepool", Quals: append(projectQual(), ovhtest.Equals("kube_id", "kube-1"), ovhtest.Equals("id", "np-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "np-1", "project_id": "p1", "kube_id": "kube-1", "min_nodes": int64(0), "max_nodes": int64(5)})
+}
@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudKubeList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube", Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":                  "p1",
+		"id":                          "kube-1",
+		"name":                        "production",
+		"region":                      "GRA7",
+		"version":                     "1.29",
+		"status":                      "READY",
+		"update_policy":               "MINIMAL_DOWNTIME",
+		"is_up_to_date":               false,
+		"control_plane_is_up_to_date": true,
+		"next_upgrade_versions":       []interface{}{"1.30"},
+		"private_network_id":          "pn-1",
+		"nodes_subnet_id":             "sub-1",
+		"load_balancers_subnet_id":    "sub-2",
+		"private_network_configuration": map[string]interface{}{
+			"defaultVrackGateway":            "10.0.0.1",
+			"privateNetworkRoutingAsDefault": true,
+		},
+		"kube_proxy_mode": "iptables",
+		"url":             "abc123.c1.gra7.k8s.ovh.net",
+		"nodes_url":       "abc123.nodes.c1.gra7.k8s.ovh.net",
+		"created_at":      mustParseTime(t, "2024-02-01T00:00:00Z"),
+		"updated_at":      mustParseTime(t, "2024-03-01T00:00:00Z"),
+		"title":           "production",
+	})
+	checkRow(t, rows[1], ovhtest.Row{
+		"id":                            "kube-2",
+		"is_up_to_date":                 true,
+		"private_network_id":            nil,
+		"private_network_configuration": nil,
+	})
+}
+
+func TestCloudKubeGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_kube"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_kube", Quals: append(projectQual(), ovhtest.Equals("id", "kube-2"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "kube-2", "project_id": "p1", "name": "staging", "version": "1.30"})
+}
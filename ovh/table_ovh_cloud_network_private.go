@@ -0,0 +1,155 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudNetworkPrivate() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_network_private",
+		Description: "A private network of the vRack of a cloud project.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listNetworkPrivate,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getNetworkPrivate,
+			IgnoreConfig: notFoundIgnoreConfig(),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the network.",
+			},
+			{
+				Name:        "vlan_id",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VlanID"),
+				Description: "VLAN ID of the network in the vRack.",
+			},
+			{
+				Name:        "regions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Regions of the network, with their status and OpenStack ID.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the network.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the network.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type NetworkPrivate struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	VlanID    int             `json:"vlanId"`
+	Regions   []NetworkRegion `json:"regions"`
+	Status    string          `json:"status"`
+	Type      string          `json:"type"`
+	ProjectID string          `json:"-"`
+}
+
+type NetworkRegion struct {
+	Region      string `json:"region"`
+	Status      string `json:"status"`
+	OpenstackID string `json:"openstackId"`
+}
+
+func (network NetworkPrivate) urn(b urnBuilder) string {
+	return b.projectResource(network.ProjectID, "network/private/"+network.ID)
+}
+
+func listNetworkPrivate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.listNetworkPrivate", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	var networks []NetworkPrivate
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/network/private", projectId), &networks)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.listNetworkPrivate", err)
+		return nil, err
+	}
+	for _, network := range networks {
+		network.ProjectID = projectId
+		d.StreamListItem(ctx, network)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+func getNetworkPrivate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.getNetworkPrivate", "connection_error", err)
+		return nil, err
+	}
+
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var network NetworkPrivate
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, id), &network)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.getNetworkPrivate", err)
+		return nil, err
+	}
+	network.ProjectID = projectId
+	return network, nil
+}
+
+// listNetworkPrivateIds returns the network given in the network_id qual, or every private
+// network of the project, for the tables of the objects of a network.
+func listNetworkPrivateIds(client *ovh.Client, d *plugin.QueryData, projectId string) ([]string, error) {
+	if networkId := d.EqualsQuals["network_id"].GetStringValue(); networkId != "" {
+		return []string{networkId}, nil
+	}
+	var networks []NetworkPrivate
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/network/private", projectId), &networks)
+	networkIds := make([]string, 0, len(networks))
+	for _, network := range networks {
+		networkIds = append(networkIds, network.ID)
+	}
+	return networkIds, err
+}
@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudNetworkPrivateList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_network_private"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_network_private", Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id": "p1",
+		"id":         "pn-1_0",
+		"name":       "backend",
+		"vlan_id":    int64(0),
+		"regions": []interface{}{
+			map[string]interface{}{"region": "GRA11", "status": "ACTIVE", "openstackId": "os-1"},
+			map[string]interface{}{"region": "SBG5", "status": "ACTIVE", "openstackId": "os-2"},
+		},
+		"status": "ACTIVE",
+		"type":   "private",
+		"title":  "backend",
+	})
+	checkRow(t, rows[1], ovhtest.Row{"id": "pn-1_42", "vlan_id": int64(42), "status": "BUILDING"})
+}
+
+func TestCloudNetworkPrivateGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_network_private"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_network_private", Quals: append(projectQual(), ovhtest.Equals("id", "pn-1_0"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "pn-1_0", "project_id": "p1", "name": "backend"})
+}
@@ -0,0 +1,129 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhCloudNetworkSubnet() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_network_subnet",
+		Description: "A subnet of a private network of a cloud project.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "network_id"}),
+			Hydrate:       listNetworkSubnet,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "network_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkID"),
+				Description: "ID of the private network of the subnet.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Subnet ID.",
+			},
+			{
+				Name:        "cidr",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("CIDR"),
+				Description: "IP block of the subnet.",
+			},
+			{
+				Name:        "dhcp_enabled",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DhcpEnabled"),
+				Description: "True if DHCP is enabled on the subnet.",
+			},
+			{
+				Name:        "gateway_ip",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("GatewayIP"),
+				Description: "IP address of the gateway of the subnet, null if the subnet has no gateway.",
+			},
+			{
+				Name:        "ip_pools",
+				Type:        proto.ColumnType_JSON,
+				Description: "IP pools of the subnet in each region, with their range and DHCP setting.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CIDR"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
+	}
+}
+
+type NetworkSubnet struct {
+	ID          string          `json:"id"`
+	CIDR        string          `json:"cidr"`
+	DhcpEnabled bool            `json:"dhcpEnabled"`
+	GatewayIP   string          `json:"gatewayIp"`
+	IPPools     []NetworkIPPool `json:"ipPools"`
+	ProjectID   string          `json:"-"`
+	NetworkID   string          `json:"-"`
+}
+
+type NetworkIPPool struct {
+	Region  string `json:"region"`
+	Network string `json:"network"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Dhcp    bool   `json:"dhcp"`
+}
+
+func (subnet NetworkSubnet) urn(b urnBuilder) string {
+	return b.projectResource(subnet.ProjectID, "network/private/"+subnet.NetworkID+"/subnet/"+subnet.ID)
+}
+
+func listNetworkSubnet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_subnet.listNetworkSubnet", "connection_error", err)
+		return nil, err
+	}
+	projectId := h.Item.(Project).ID
+	networkIds, err := listNetworkPrivateIds(client, d, projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_subnet.listNetworkSubnet", err)
+		return nil, err
+	}
+	for _, networkId := range networkIds {
+		var subnets []NetworkSubnet
+		err = client.Get(fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId), &subnets)
+		if err != nil {
+			if isNotFoundError(err) {
+				// the network was deleted since it was listed
+				continue
+			}
+			plugin.Logger(ctx).Error("ovh_cloud_network_subnet.listNetworkSubnet", err)
+			return nil, err
+		}
+		for _, subnet := range subnets {
+			subnet.ProjectID = projectId
+			subnet.NetworkID = networkId
+			d.StreamListItem(ctx, subnet)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudNetworkSubnetList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_network_subnet"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_network_subnet", Quals: projectQual()}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":   "p1",
+		"network_id":   "pn-1_0",
+		"id":           "sub-1",
+		"cidr":         "10.0.0.0/16",
+		"dhcp_enabled": true,
+		"gateway_ip":   "10.0.0.1",
+		"ip_pools": []interface{}{
+			map[string]interface{}{"region": "GRA11", "network": "10.0.0.0/16", "start": "10.0.0.2", "end": "10.0.255.254", "dhcp": true},
+		},
+		"title": "10.0.0.0/16",
+	})
+	checkRow(t, rows[1], ovhtest.Row{
+		"network_id":   "pn-1_42",
+		"id":           "sub-2",
+		"cidr":         "10.0.128.0/24",
+		"dhcp_enabled": false,
+		"gateway_ip":   nil,
+		"ip_pools":     []interface{}{},
+	})
+}
+
+func TestCloudNetworkSubnetListNetwork(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_network_subnet"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_network_subnet", Columns: []string{"id"}, Quals: append(projectQual(), ovhtest.Equals("network_id", "pn-1_42"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "sub-2"})
+	for _, request := range server.Requests() {
+		if request == "GET /cloud/project/p1/network/private" {
+			t.Errorf("expected the networks not to be listed, got %v", server.Requests())
+		}
+	}
+}
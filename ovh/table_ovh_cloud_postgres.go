@@ -14,18 +14,23 @@ func tableOvhCloudPostgres() *plugin.Table {
 		Name:        "ovh_cloud_postgres",
 		Description: "An hosted PostgreSQL database.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listPostgres,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listPostgres,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getPostgres,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getPostgres,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		HydrateConfig: []plugin.HydrateConfig{
+			{Func: getPostgresInfo, IgnoreConfig: notFoundIgnoreConfig()},
+		},
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -87,13 +92,21 @@ func tableOvhCloudPostgres() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "The VM flavor used for this cluster.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPostgresInfo,
+				Transform:   transform.FromField("Description"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 
 }
 func getPostgresInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	postgres := h.Item.(Database)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -101,7 +114,7 @@ func getPostgresInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql/%s", projectId, postgres.ID), &postgres)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql/%s", postgres.ProjectID, postgres.ID), &postgres)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_postgres.getPostgresInfo", err)
 		return nil, err
@@ -109,13 +122,13 @@ func getPostgresInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	return postgres, nil
 }
 
-func listPostgres(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listPostgres(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_postgres.listPostgres", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var postgresIds []string
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql", projectId), &postgresIds)
 	if err != nil {
@@ -125,14 +138,20 @@ func listPostgres(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	for _, postgresId := range postgresIds {
 		var postgres Database
 		postgres.ID = postgresId
+		postgres.ProjectID = projectId
 		d.StreamListItem(ctx, postgres)
 	}
 	return nil, nil
 }
 
 func getPostgres(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
 	id := d.EqualsQuals["id"].GetStringValue()
+	if !projectIncluded(d, projectId) {
+		return nil, nil
+	}
 	var postgres Database
 	postgres.ID = id
+	postgres.ProjectID = projectId
 	return postgres, nil
 }
@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudPostgresList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_postgres"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_postgres", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":   "p1",
+		"id":           "pg-1",
+		"engine":       "postgresql",
+		"plan":         "business",
+		"created_at":   mustParseTime(t, "2024-01-05T00:00:00Z"),
+		"status":       "READY",
+		"node_number":  "3",
+		"description":  "postgres",
+		"version":      "15",
+		"network_type": "private",
+		"flavor":       "db1-7",
+	})
+}
+
+func TestCloudPostgresGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_postgres"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_postgres", Quals: append(projectQual(), ovhtest.Equals("id", "pg-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "pg-1", "project_id": "p1", "version": "15"})
+}
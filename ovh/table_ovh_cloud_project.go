@@ -18,13 +18,14 @@ func tableOvhCloudProject() *plugin.Table {
 			Hydrate: listProject,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("id"),
-			Hydrate:    getProject,
+			KeyColumns:   plugin.SingleColumn("id"),
+			Hydrate:      getProject,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getProjectInfo},
+			{Func: getProjectInfo, IgnoreConfig: notFoundIgnoreConfig()},
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -52,6 +53,7 @@ func tableOvhCloudProject() *plugin.Table {
 				Name:        "order_id",
 				Hydrate:     getProjectInfo,
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrderId"),
 				Description: "Project order ID.",
 			},
 			{
@@ -93,7 +95,22 @@ func tableOvhCloudProject() *plugin.Table {
 				Transform:   transform.FromField("IAM"),
 				Description: "IAM resource metadata.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProjectInfo,
+				Transform:   transform.FromField("Description"),
+				Description: "Title of the resource.",
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getProjectInfo,
+				Transform:   transform.FromField("IAM.Tags"),
+				Description: "A map of tags for the resource.",
+			},
+		}),
 	}
 }
 
@@ -119,6 +136,13 @@ type Project struct {
 	IAM          *IAMResourceMetadata `json:"iam,omitempty"`
 }
 
+func (project Project) urn(b urnBuilder) string {
+	if project.IAM != nil && project.IAM.URN != "" {
+		return project.IAM.URN
+	}
+	return b.resource("publicCloudProject", project.ID)
+}
+
 func getProjectInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	project := h.Item.(Project)
 
@@ -149,6 +173,9 @@ func listProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 		return nil, err
 	}
 	for _, projectId := range projects {
+		if !projectIncluded(d, projectId) {
+			continue
+		}
 		var project Project
 		project.ID = projectId
 		d.StreamListItem(ctx, project)
@@ -156,9 +183,25 @@ func listProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	return nil, nil
 }
 
+// listProjectParent is the parent hydrate of the per-project tables.
+// It streams the project given in the project_id qual, or every project of the account.
+func listProjectParent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	if projectId != "" {
+		if projectIncluded(d, projectId) {
+			d.StreamListItem(ctx, Project{ID: projectId})
+		}
+		return nil, nil
+	}
+	return listProject(ctx, d, h)
+}
+
 func getProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
 	projectId := quals["id"].GetStringValue()
+	if !projectIncluded(d, projectId) {
+		return nil, nil
+	}
 	var project Project
 	project.ID = projectId
 	return project, nil
@@ -0,0 +1,79 @@
+package ovh
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudProjectList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_project"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_project"}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":           "p1",
+		"name":         "production",
+		"description":  "Production",
+		"plan_code":    "project.2018",
+		"order_id":     "42",
+		"status":       "ok",
+		"unleash":      false,
+		"manual_quota": false,
+		"created_at":   mustParseTime(t, "2020-01-01T00:00:00+01:00"),
+		"iam": map[string]interface{}{
+			"id":          "6f0b3f3e-0000-4000-8000-000000000001",
+			"urn":         "urn:v1:eu:resource:publicCloudProject:p1",
+			"displayName": "production",
+			"tags":        map[string]interface{}{"env": "prod"},
+		},
+	})
+	checkRow(t, rows[1], ovhtest.Row{"id": "p2", "name": "staging", "unleash": true, "iam": nil})
+}
+
+func TestCloudProjectGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_project"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_project", Quals: []ovhtest.Qual{ovhtest.Equals("id", "p2")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "p2", "name": "staging"})
+}
+
+func TestCloudProjectFanOut(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_project", "ovh_cloud_ssh_key"})
+	server.Handle(http.MethodGet, "/cloud/project/p2/sshkey", http.StatusOK, []SshKey{{ID: "sk-2", Name: "ci"}})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_cloud_ssh_key"}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{"id": "sk-1", "project_id": "p1"})
+	checkRow(t, rows[1], ovhtest.Row{"id": "sk-2", "project_id": "p2"})
+}
+
+func TestCloudProjectFilters(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_project", "ovh_cloud_ssh_key"}, `projects = ["*", "!p2"]`)
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_project", Columns: []string{"id"}})
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "p1"})
+
+	rows = execute(t, p, ovhtest.Query{Table: "ovh_cloud_project", Quals: []ovhtest.Qual{ovhtest.Equals("id", "p2")}})
+	checkRowCount(t, rows, 0)
+
+	rows = execute(t, p, ovhtest.Query{Table: "ovh_cloud_ssh_key", Columns: []string{"id", "project_id"}})
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "sk-1", "project_id": "p1"})
+
+	rows = execute(t, p, ovhtest.Query{Table: "ovh_cloud_ssh_key", Quals: []ovhtest.Qual{ovhtest.Equals("project_id", "p2")}})
+	checkRowCount(t, rows, 0)
+
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET /cloud/project/p2") {
+			t.Errorf("unexpected request to the excluded project: %s", request)
+		}
+	}
+}
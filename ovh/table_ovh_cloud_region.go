@@ -14,18 +14,23 @@ func tableOvhCloudRegion() *plugin.Table {
 		Name:        "ovh_cloud_region",
 		Description: "Regions available for a cloud project.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listRegion,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listRegion,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "name"}),
-			Hydrate:    getRegion,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "name"}),
+			Hydrate:      getRegion,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		HydrateConfig: []plugin.HydrateConfig{
+			{Func: getRegionInfo, IgnoreConfig: notFoundIgnoreConfig()},
+		},
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -49,6 +54,7 @@ func tableOvhCloudRegion() *plugin.Table {
 				Name:        "ip_countries",
 				Hydrate:     getRegionInfo,
 				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IpCountries"),
 				Description: "Allowed countries for failover ip.",
 			},
 			{
@@ -69,7 +75,15 @@ func tableOvhCloudRegion() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Region type.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 
 }
@@ -82,6 +96,11 @@ type Region struct {
 	Services           []Component `json:"services"`
 	Status             string      `json:"status"`
 	Type               string      `json:"type"`
+	ProjectID          string      `json:"-"`
+}
+
+func (region Region) urn(b urnBuilder) string {
+	return b.projectResource(region.ProjectID, "region/"+region.Name)
 }
 
 type Component struct {
@@ -92,7 +111,6 @@ type Component struct {
 
 func getRegionInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	region := h.Item.(Region)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -100,7 +118,7 @@ func getRegionInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s", projectId, region.Name), &region)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s", region.ProjectID, region.Name), &region)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_region.getRegionInfo", err)
 		return nil, err
@@ -108,13 +126,13 @@ func getRegionInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	return region, nil
 }
 
-func listRegion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listRegion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_region.listRegion", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var regionNames []string
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/region", projectId), &regionNames)
 	if err != nil {
@@ -122,16 +140,25 @@ func listRegion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		return nil, err
 	}
 	for _, regionName := range regionNames {
+		if !regionIncluded(d, regionName) {
+			continue
+		}
 		var region Region
 		region.Name = regionName
+		region.ProjectID = projectId
 		d.StreamListItem(ctx, region)
 	}
 	return nil, nil
 }
 
 func getRegion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
 	name := d.EqualsQuals["name"].GetStringValue()
+	if !projectIncluded(d, projectId) || !regionIncluded(d, name) {
+		return nil, nil
+	}
 	var region Region
 	region.Name = name
+	region.ProjectID = projectId
 	return region, nil
 }
@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudRegionList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_region"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_region", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":          "p1",
+		"name":                "GRA11",
+		"continent_code":      "EU",
+		"datacenter_location": "GRA",
+		"ip_countries":        []interface{}{"fr"},
+		"services":            []interface{}{map[string]interface{}{"endpoint": "https://compute.gra11.cloud.ovh.net", "name": "instance", "status": "UP"}},
+		"status":              "UP",
+		"type":                "region-3-az",
+	})
+}
+
+func TestCloudRegionGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_region"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_region", Quals: append(projectQual(), ovhtest.Equals("name", "GRA11"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"name": "GRA11", "project_id": "p1", "status": "UP"})
+}
+
+func TestCloudRegionFilters(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_region"}, `regions = ["SBG*", "BHS*"]`)
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_region", Quals: projectQual()})
+	checkRowCount(t, rows, 0)
+
+	rows = execute(t, p, ovhtest.Query{Table: "ovh_cloud_region", Quals: append(projectQual(), ovhtest.Equals("name", "GRA11"))})
+	checkRowCount(t, rows, 0)
+}
@@ -14,18 +14,20 @@ func tableOvhCloudSshKey() *plugin.Table {
 		Name:        "ovh_cloud_ssh_key",
 		Description: "An ssh key allows you to connect to an instance.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listSshKey,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:       listSshKey,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getSshKey,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getSshKey,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -43,36 +45,65 @@ func tableOvhCloudSshKey() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "SSH public key.",
 			},
-		},
+			{
+				Name:        "regions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Regions where the SSH key is available.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("region"),
+				Description: "Region used to list the SSH keys, only set when the query filters on it.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
 type SshKey struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	PublicKey string `json:"publicKey"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	PublicKey string   `json:"publicKey"`
+	Regions   []string `json:"regions"`
+	ProjectID string   `json:"-"`
+}
+
+func (sshKey SshKey) urn(b urnBuilder) string {
+	return b.projectResource(sshKey.ProjectID, "sshkey/"+sshKey.ID)
 }
 
-func listSshKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listSshKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.listSshKey", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var sshKeys []SshKey
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/sshkey", projectId), &sshKeys)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/sshkey", projectId)+equalsQualsQuery(d, map[string]string{"region": "region"}), &sshKeys)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.listSshKey", err)
 		return nil, err
 	}
 	for _, sshKey := range sshKeys {
+		sshKey.ProjectID = projectId
 		d.StreamListItem(ctx, sshKey)
 	}
 	return nil, nil
 }
 
 func getSshKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.getSshKey", "connection_error", err)
@@ -86,5 +117,6 @@ func getSshKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.getSshKey", err)
 		return nil, err
 	}
+	sshKey.ProjectID = projectId
 	return sshKey, nil
 }
@@ -0,0 +1,32 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudSshKeyList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ssh_key"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ssh_key", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id": "p1",
+		"id":         "sk-1",
+		"name":       "laptop",
+		"public_key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAITest user@laptop",
+		"regions":    []interface{}{"GRA11", "SBG5"},
+		"region":     nil,
+	})
+}
+
+func TestCloudSshKeyGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_ssh_key"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_ssh_key", Quals: append(projectQual(), ovhtest.Equals("id", "sk-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "sk-1", "project_id": "p1", "name": "laptop"})
+}
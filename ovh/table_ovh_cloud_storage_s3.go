@@ -15,18 +15,23 @@ func tableOvhCloudStorageS3() *plugin.Table {
 		Name:        "ovh_cloud_storage_s3",
 		Description: "A S3 storage is an object storage.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "region"}),
-			Hydrate:    listS3StorageContainer,
+			ParentHydrate: listProjectParent,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Required},
+			},
+			Hydrate: listS3StorageContainer,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "region", "name"}),
-			Hydrate:    getS3StorageContainer,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "name"}),
+			Hydrate:      getS3StorageContainer,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -70,7 +75,15 @@ func tableOvhCloudStorageS3() *plugin.Table {
 				Description: "Encryption configuration.",
 				Transform:   transform.FromField("Encryption.SSEAlgorithm"),
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -83,19 +96,28 @@ type S3StorageContainer struct {
 	Region       string                       `json:"region"`
 	CreatedAt    time.Time                    `json:"createdAt"`
 	Encryption   S3StorageContainerEncryption `json:"encryption"`
+	ProjectID    string                       `json:"-"`
+}
+
+func (container S3StorageContainer) urn(b urnBuilder) string {
+	return b.projectResource(container.ProjectID, "region/"+container.Region+"/storage/"+container.Name)
 }
+
 type S3StorageContainerEncryption struct {
 	SSEAlgorithm string `json:"sseAlgorithm"`
 }
 
-func listS3StorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listS3StorageContainer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQuals["region"].GetStringValue()
+	if !regionIncluded(d, region) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_s3.listS3StorageContainer", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	region := d.EqualsQuals["region"].GetStringValue()
+	projectId := h.Item.(Project).ID
 
 	var containers []S3StorageContainer
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/storage", projectId, region), &containers)
@@ -104,12 +126,16 @@ func listS3StorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.
 		return nil, err
 	}
 	for _, container := range containers {
+		container.ProjectID = projectId
 		d.StreamListItem(ctx, container)
 	}
 	return nil, nil
 }
 
 func getS3StorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) || !regionIncluded(d, d.EqualsQuals["region"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_s3.getS3StorageContainer", "connection_error", err)
@@ -124,5 +150,6 @@ func getS3StorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 		plugin.Logger(ctx).Error("ovh_cloud_storage_s3.getS3StorageContainer", err)
 		return nil, err
 	}
+	container.ProjectID = projectId
 	return container, nil
 }
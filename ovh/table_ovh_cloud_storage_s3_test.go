@@ -0,0 +1,46 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudStorageS3List(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_storage_s3"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_storage_s3", Quals: append(projectQual(), ovhtest.Equals("region", "GRA"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":               "p1",
+		"name":                     "backups",
+		"virtual_host":             "backups.s3.gra.io.cloud.ovh.net",
+		"owner_id":                 int64(1234),
+		"objects_count":            int64(10),
+		"objects_size":             int64(2048),
+		"region":                   "GRA",
+		"created_at":               mustParseTime(t, "2024-01-08T00:00:00Z"),
+		"encryption_sse_algorithm": "AES256",
+	})
+}
+
+func TestCloudStorageS3Get(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_storage_s3"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_storage_s3", Quals: append(projectQual(), ovhtest.Equals("region", "GRA"), ovhtest.Equals("name", "backups"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"name": "backups", "project_id": "p1", "objects_count": int64(10)})
+}
+
+func TestCloudStorageS3RegionFilters(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_cloud_storage_s3"}, `regions = ["!GRA"]`)
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_storage_s3", Quals: append(projectQual(), ovhtest.Equals("region", "GRA"))})
+
+	checkRowCount(t, rows, 0)
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("expected no request for the excluded region, got %v", requests)
+	}
+}
@@ -14,18 +14,20 @@ func tableOvhCloudStorageSwift() *plugin.Table {
 		Name:        "ovh_cloud_storage_swift",
 		Description: "A Swift storage is the OpenStack object storage.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listSwiftStorageContainer,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listSwiftStorageContainer,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getSwiftStorageContainer,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getSwiftStorageContainer,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -53,7 +55,15 @@ func tableOvhCloudStorageSwift() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Region of the container.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -63,15 +73,20 @@ type SwiftStorageContainer struct {
 	StoredObjects int    `json:"storedObjects"`
 	StoredBytes   int    `json:"storedBytes"`
 	Region        string `json:"region"`
+	ProjectID     string `json:"-"`
 }
 
-func listSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func (container SwiftStorageContainer) urn(b urnBuilder) string {
+	return b.projectResource(container.ProjectID, "storage/"+container.ID)
+}
+
+func listSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_swift.listSwiftStorageContainer", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var containers []SwiftStorageContainer
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/storage", projectId), &containers)
 	if err != nil {
@@ -79,12 +94,16 @@ func listSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, _ *plug
 		return nil, err
 	}
 	for _, container := range containers {
+		container.ProjectID = projectId
 		d.StreamListItem(ctx, container)
 	}
 	return nil, nil
 }
 
 func getSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_swift.getSwiftStorageContainer", "connection_error", err)
@@ -99,5 +118,6 @@ func getSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugi
 		return nil, err
 	}
 	container.ID = id
+	container.ProjectID = projectId
 	return container, nil
 }
@@ -0,0 +1,32 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudStorageSwiftList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_storage_swift"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_storage_swift", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":     "p1",
+		"id":             "c-1",
+		"name":           "assets",
+		"stored_objects": int64(5),
+		"stored_bytes":   int64(1024),
+		"region":         "GRA",
+	})
+}
+
+func TestCloudStorageSwiftGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_storage_swift"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_storage_swift", Quals: append(projectQual(), ovhtest.Equals("id", "c-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "c-1", "project_id": "p1", "name": "assets"})
+}
@@ -15,18 +15,20 @@ func tableOvhCloudVolume() *plugin.Table {
 		Name:        "ovh_cloud_volume",
 		Description: "A volume is an independent additional disk.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listVolume,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:       listVolume,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getVolume,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getVolume,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -85,7 +87,15 @@ func tableOvhCloudVolume() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Volume type (classic, high-speed, high-speed-gen2",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -101,28 +111,37 @@ type Volume struct {
 	Bootable     bool      `json:"bootable"`
 	PlanCode     string    `json:"planCode"`
 	Type         string    `json:"type"`
+	ProjectID    string    `json:"-"`
 }
 
-func listVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func (volume Volume) urn(b urnBuilder) string {
+	return b.projectResource(volume.ProjectID, "volume/"+volume.ID)
+}
+
+func listVolume(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolume", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var volumes []Volume
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/volume", projectId), &volumes)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/volume", projectId)+equalsQualsQuery(d, map[string]string{"region": "region"}), &volumes)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolume", err)
 		return nil, err
 	}
 	for _, volume := range volumes {
+		volume.ProjectID = projectId
 		d.StreamListItem(ctx, volume)
 	}
 	return nil, nil
 }
 
 func getVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.getVolume", "connection_error", err)
@@ -136,5 +155,6 @@ func getVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		plugin.Logger(ctx).Error("ovh_cloud_volume.getVolume", err)
 		return nil, err
 	}
+	volume.ProjectID = projectId
 	return volume, nil
 }
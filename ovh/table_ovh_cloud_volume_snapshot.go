@@ -15,18 +15,20 @@ func tableOvhCloudVolumeSnapshot() *plugin.Table {
 		Name:        "ovh_cloud_volume_snapshot",
 		Description: "A volume snapshot a copy of the state of a storage volume at a particular point in time.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listVolumeSnapshot,
+			ParentHydrate: listProjectParent,
+			KeyColumns:    plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:       listVolumeSnapshot,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getVolumeSnapshot,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getVolumeSnapshot,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -76,7 +78,15 @@ func tableOvhCloudVolumeSnapshot() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Volume Snapshot Plan Code.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -90,15 +100,20 @@ type VolumeSnapShot struct {
 	Region       string    `json:"region"`
 	Status       string    `json:"status"`
 	PlanCode     string    `json:"planCode"`
+	ProjectID    string    `json:"-"`
 }
 
-func listVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func (snapshot VolumeSnapShot) urn(b urnBuilder) string {
+	return b.projectResource(snapshot.ProjectID, "volume/snapshot/"+snapshot.ID)
+}
+
+func listVolumeSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolumeSnapshot", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	projectId := h.Item.(Project).ID
 	var volumes []VolumeSnapShot
 	err = client.Get(fmt.Sprintf("/cloud/project/%s/volume/snapshot", projectId), &volumes)
 	if err != nil {
@@ -106,12 +121,16 @@ func listVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		return nil, err
 	}
 	for _, volume := range volumes {
+		volume.ProjectID = projectId
 		d.StreamListItem(ctx, volume)
 	}
 	return nil, nil
 }
 
 func getVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if !projectIncluded(d, d.EqualsQuals["project_id"].GetStringValue()) {
+		return nil, nil
+	}
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.getVolumeSnapshot", "connection_error", err)
@@ -125,5 +144,6 @@ func getVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 		plugin.Logger(ctx).Error("ovh_cloud_volume.getVolumeSnapshot", err)
 		return nil, err
 	}
+	volume.ProjectID = projectId
 	return volume, nil
 }
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudVolumeSnapshotList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_volume_snapshot"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_volume_snapshot", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":   "p1",
+		"id":           "vs-1",
+		"creationDate": mustParseTime(t, "2024-01-10T00:00:00Z"),
+		"name":         "data-snapshot",
+		"description":  "daily",
+		"size":         int64(100),
+		"volumeId":     "vo-1",
+		"region":       "GRA11",
+		"status":       "available",
+		"planCode":     "volume.snapshot.consumption",
+	})
+}
+
+func TestCloudVolumeSnapshotGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_volume_snapshot"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_volume_snapshot", Quals: append(projectQual(), ovhtest.Equals("id", "vs-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "vs-1", "project_id": "p1", "volumeId": "vo-1"})
+}
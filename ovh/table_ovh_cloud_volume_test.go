@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestCloudVolumeList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_volume"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_volume", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":  "p1",
+		"id":          "vo-1",
+		"name":        "data",
+		"region":      "GRA11",
+		"attached_to": []interface{}{"in-1"},
+		"created_at":  mustParseTime(t, "2024-01-09T00:00:00Z"),
+		"description": "data volume",
+		"size":        int64(100),
+		"status":      "in-use",
+		"bootable":    false,
+		"planCode":    "volume.high-speed.consumption",
+		"type":        "high-speed",
+	})
+}
+
+func TestCloudVolumeGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_cloud_volume"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_cloud_volume", Quals: append(projectQual(), ovhtest.Equals("id", "vo-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "vo-1", "project_id": "p1", "name": "data"})
+}
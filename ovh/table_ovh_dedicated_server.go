@@ -3,6 +3,7 @@ package ovh
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -16,7 +17,8 @@ func tableOvhDedicatedServer(ctx context.Context) *plugin.Table {
 		Name:        "ovh_dedicated_server",
 		Description: "OVH Dedicated Server inventory with hardware and configuration details.",
 		List: &plugin.ListConfig{
-			Hydrate: listDedicatedServers,
+			KeyColumns: plugin.KeyColumnSlice{iamTagsKeyColumn},
+			Hydrate:    listDedicatedServers,
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
@@ -24,10 +26,11 @@ func tableOvhDedicatedServer(ctx context.Context) *plugin.Table {
 			},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getDedicatedServer,
+			KeyColumns:   plugin.SingleColumn("name"),
+			Hydrate:      getDedicatedServer,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name/hostname of the dedicated server.",
@@ -196,7 +199,21 @@ func tableOvhDedicatedServer(ctx context.Context) *plugin.Table {
 				Transform:   transform.FromField("Iam.Urn"),
 				Hydrate:     getDedicatedServer,
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+				Description: "Title of the resource.",
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDedicatedServer,
+				Transform:   transform.FromField("Iam.Tags"),
+				Description: "A map of tags for the resource.",
+			},
+		}),
 	}
 }
 
@@ -210,7 +227,11 @@ func listDedicatedServers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	}
 
 	var serverNames []string
-	if err := client.Get("/dedicated/server", &serverNames); err != nil {
+	path := "/dedicated/server"
+	if filter := iamTagsFilter(d); filter != "" {
+		path += "?" + url.Values{"iamTags": {filter}}.Encode()
+	}
+	if err := client.Get(path, &serverNames); err != nil {
 		plugin.Logger(ctx).Error("ovh_dedicated_server.listDedicatedServers", "api_error", err)
 		return nil, err
 	}
@@ -278,8 +299,16 @@ type DedicatedServer struct {
 	Iam               IAM     `json:"iam"`
 }
 
+func (server DedicatedServer) urn(b urnBuilder) string {
+	if server.Iam.Urn != "" {
+		return server.Iam.Urn
+	}
+	return b.resource("dedicatedServer", server.Name)
+}
+
 type IAM struct {
-	DisplayName string `json:"displayName"`
-	Id          string `json:"id"`
-	Urn         string `json:"urn"`
+	DisplayName string            `json:"displayName"`
+	Id          string            `json:"id"`
+	Urn         string            `json:"urn"`
+	Tags        map[string]string `json:"tags"`
 }
@@ -0,0 +1,72 @@
+package ovh
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestDedicatedServerList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_dedicated_server"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_dedicated_server"})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"name":                "ns1.ip-192-0-2.eu",
+		"server_id":           int64(123),
+		"ip":                  "192.0.2.10",
+		"reverse":             "ns1.ip-192-0-2.eu",
+		"state":               "ok",
+		"power_state":         "poweron",
+		"monitoring":          true,
+		"os":                  "debian12_64",
+		"datacenter":          "rbx8",
+		"region":              "eu-west-rbx",
+		"availability_zone":   "eu-west-rbx-a",
+		"rack":                "R01",
+		"commercial_range":    "advance",
+		"link_speed":          int64(1000),
+		"support_level":       "pro",
+		"boot_id":             int64(1),
+		"boot_script":         nil,
+		"rescue_mail":         "admin@example.com",
+		"new_upgrade_system":  true,
+		"efi_bootloader_path": nil,
+		"iam_display_name":    "ns1.ip-192-0-2.eu",
+		"iam_id":              "7a1b2c3d-0000-4000-8000-000000000001",
+		"iam_urn":             "urn:v1:eu:resource:dedicatedServer:ns1.ip-192-0-2.eu",
+	})
+}
+
+func TestDedicatedServerGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_dedicated_server"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_dedicated_server", Quals: []ovhtest.Qual{ovhtest.Equals("name", "ns1.ip-192-0-2.eu")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"name": "ns1.ip-192-0-2.eu", "server_id": int64(123)})
+}
+
+func TestDedicatedServerListTags(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_dedicated_server"})
+	var filter string
+	server.HandleFunc(http.MethodGet, "/dedicated/server", func(w http.ResponseWriter, r *http.Request) {
+		filter = r.URL.Query().Get("iamTags")
+		_, _ = w.Write([]byte(`["ns1.ip-192-0-2.eu"]`))
+	})
+
+	rows := execute(t, p, ovhtest.Query{
+		Table:   "ovh_dedicated_server",
+		Columns: []string{"name", "tags"},
+		Quals:   []ovhtest.Qual{ovhtest.Equals("tags", json.RawMessage(`{"team": "infra"}`))},
+	})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"name": "ns1.ip-192-0-2.eu", "tags": map[string]interface{}{"env": "prod", "team": "infra"}})
+	if filter != `{"team":[{"operator":"EQ","value":"infra"}]}` {
+		t.Errorf("unexpected iamTags filter %s", filter)
+	}
+}
@@ -2,6 +2,7 @@ package ovh
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -13,9 +14,13 @@ func tableOvhIamResource() *plugin.Table {
 		Name:        "ovh_iam_resource",
 		Description: "IAM resources in the OVH account.",
 		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				iamTagsKeyColumn,
+				{Name: "type", Require: plugin.Optional},
+			},
 			Hydrate: listIamResource,
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -50,9 +55,17 @@ func tableOvhIamResource() *plugin.Table {
 			{
 				Name:        "tags",
 				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
 				Description: "Resource tags. Tags that were internally computed are prefixed with ovh:.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+				Description: "Title of the resource.",
+			},
+		}),
 	}
 }
 
@@ -66,6 +79,10 @@ type IamResource struct {
 	Tags        map[string]string `json:"tags,omitempty"`
 }
 
+func (resource IamResource) urn(b urnBuilder) string {
+	return resource.URN
+}
+
 func listIamResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -73,16 +90,33 @@ func listIamResource(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 		return nil, err
 	}
 
-	var resources []IamResource
-	err = client.Get("/v2/iam/resource", &resources)
+	params := url.Values{}
+	if filter := iamTagsFilter(d); filter != "" {
+		params.Set("iamTags", filter)
+	}
+	if quals := d.EqualsQuals["type"]; quals != nil {
+		if list := quals.GetListValue(); list != nil {
+			for _, value := range list.Values {
+				params.Add("resourceType", value.GetStringValue())
+			}
+		} else {
+			params.Set("resourceType", quals.GetStringValue())
+		}
+	}
+	path := "/v2/iam/resource"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	err = getV2Paginated(ctx, d, client, path, func(resources []IamResource) {
+		for _, resource := range resources {
+			d.StreamListItem(ctx, resource)
+		}
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_iam_resource.listIamResource", err)
 		return nil, err
 	}
 
-	for _, resource := range resources {
-		d.StreamListItem(ctx, resource)
-	}
-
 	return nil, nil
 }
@@ -0,0 +1,75 @@
+package ovh
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestIamResourceList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_iam_resource"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_iam_resource"}), "name")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":           "7a1b2c3d-0000-4000-8000-000000000001",
+		"urn":          "urn:v1:eu:resource:dedicatedServer:ns1.ip-192-0-2.eu",
+		"name":         "ns1.ip-192-0-2.eu",
+		"display_name": "ns1.ip-192-0-2.eu",
+		"type":         "dedicatedServer",
+		"owner":        testNichandle,
+		"tags":         nil,
+	})
+	checkRow(t, rows[1], ovhtest.Row{"name": "p1", "type": "publicCloudProject", "tags": map[string]interface{}{"env": "prod"}})
+}
+
+func TestIamResourcePagination(t *testing.T) {
+	server, p := newTestPlugin(t, nil)
+	server.HandleFunc(http.MethodGet, "/v2/iam/resource", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Pagination-Cursor") == "" {
+			w.Header().Set("X-Pagination-Cursor-Next", "page-2")
+			_, _ = w.Write([]byte(`[{"id": "r1", "name": "first"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id": "r2", "name": "second"}]`))
+	})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_iam_resource", Columns: []string{"id", "name"}}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{"id": "r1", "name": "first"})
+	checkRow(t, rows[1], ovhtest.Row{"id": "r2", "name": "second"})
+}
+
+func TestIamResourceListFilters(t *testing.T) {
+	server, p := newTestPlugin(t, nil)
+	var query url.Values
+	server.HandleFunc(http.MethodGet, "/v2/iam/resource", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`[{"id": "r1", "name": "p1", "type": "publicCloudProject", "tags": {"env": "prod"}}]`))
+	})
+
+	rows := execute(t, p, ovhtest.Query{
+		Table:   "ovh_iam_resource",
+		Columns: []string{"id", "tags"},
+		Quals: []ovhtest.Qual{
+			{Column: "tags", Operator: "@>", Value: json.RawMessage(`{"env": "prod", "ovh:count": 2}`)},
+			ovhtest.Equals("type", "publicCloudProject"),
+		},
+	})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "r1"})
+	// the tags that are not strings are not filtered by the API
+	if filter := query.Get("iamTags"); filter != `{"env":[{"operator":"EQ","value":"prod"}]}` {
+		t.Errorf("unexpected iamTags filter %s", filter)
+	}
+	if types := query["resourceType"]; !reflect.DeepEqual(types, []string{"publicCloudProject"}) {
+		t.Errorf("unexpected resourceType filter %v", types)
+	}
+}
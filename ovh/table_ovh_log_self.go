@@ -8,16 +8,21 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 type Log struct {
-	ID      int       `json:"logId"`
-	Date    time.Time `json:"date"`
-	Account string    `json:"account"`
-	IP      string    `json:"ip"`
-	Method  string    `json:"method"`
-	Route   string    `json:"route"`
-	Path    string    `json:"path"`
+	ID            int       `json:"logId"`
+	Date          time.Time `json:"date"`
+	ActionAccount string    `json:"account"`
+	IP            string    `json:"ip"`
+	Method        string    `json:"method"`
+	Route         string    `json:"route"`
+	Path          string    `json:"path"`
+}
+
+func (log Log) urn(b urnBuilder) string {
+	return b.accountResource(fmt.Sprintf("api/logs/self/%d", log.ID))
 }
 
 func tableOvhLog() *plugin.Table {
@@ -28,13 +33,11 @@ func tableOvhLog() *plugin.Table {
 			Hydrate: listLog,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getLog,
-		},
-		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getLogInfo},
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getLog,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -42,61 +45,44 @@ func tableOvhLog() *plugin.Table {
 			},
 			{
 				Name:        "date",
-				Hydrate:     getLogInfo,
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Date of the log.",
 			},
 			{
-				Name:        "account",
-				Hydrate:     getLogInfo,
+				Name:        "action_account",
 				Type:        proto.ColumnType_STRING,
 				Description: "User performing the action.",
 			},
 			{
 				Name:        "ip",
-				Hydrate:     getLogInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Origin IP of the action.",
 			},
 			{
 				Name:        "method",
-				Hydrate:     getLogInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Method requested.",
 			},
 			{
 				Name:        "route",
-				Hydrate:     getLogInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Route used for the action.",
 			},
 			{
 				Name:        "path",
-				Hydrate:     getLogInfo,
 				Type:        proto.ColumnType_STRING,
 				Description: "Path used for the action with project and object IDs.",
 			},
-		},
-	}
-}
-
-func getLogInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	log := h.Item.(Log)
-
-	client, err := connect(ctx, d)
-	if err != nil {
-		plugin.Logger(ctx).Error("ovh_log_self.getLogInfo", "connection_error", err)
-		return nil, err
-	}
-
-	err = client.Get(fmt.Sprintf("/me/api/logs/self/%d", log.ID), &log)
-
-	if err != nil {
-		plugin.Logger(ctx).Error("ovh_log_self.getLogInfo", err)
-		return nil, err
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
-
-	return log, nil
 }
 
 func listLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -114,22 +100,39 @@ func listLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (i
 		return nil, err
 	}
 
-	for _, logId := range logsId {
-		var log Log
-		log.ID = logId
+	ids := make([]string, len(logsId))
+	for i, logId := range logsId {
+		ids[i] = strconv.Itoa(logId)
+	}
+
+	err = getBatched(ctx, d, client, "/me/api/logs/self", ids, func(log Log) {
 		d.StreamListItem(ctx, log)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_log_self.listLog", err)
+		return nil, err
 	}
 
 	return nil, nil
 }
 
 func getLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_log_self.getLog", "connection_error", err)
+		return nil, err
+	}
+
 	strId := d.EqualsQuals["id"].GetStringValue()
-	var log Log
 	intId, err := strconv.Atoi(strId)
 	if err != nil {
 		return nil, err
 	}
-	log.ID = intId
+	var log Log
+	err = client.Get(fmt.Sprintf("/me/api/logs/self/%d", intId), &log)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_log_self.getLog", err)
+		return nil, err
+	}
 	return log, nil
 }
@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestLogList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_log_self"})
+
+	rows := sortRows(execute(t, p, ovhtest.Query{Table: "ovh_log_self"}), "id")
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":             "11",
+		"date":           mustParseTime(t, "2024-04-01T10:00:00+02:00"),
+		"action_account": "xx1234-ovh/alice",
+		"account":        testNichandle,
+		"ip":             "192.0.2.1",
+		"method":         "GET",
+		"route":          "/me",
+		"path":           "/me",
+	})
+	checkRow(t, rows[1], ovhtest.Row{"id": "12", "method": "POST", "path": "/cloud/project/p1/instance"})
+}
+
+func TestLogGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_log_self"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_log_self", Quals: []ovhtest.Qual{ovhtest.Equals("id", "12")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "12", "ip": "192.0.2.2"})
+}
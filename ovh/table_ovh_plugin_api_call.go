@@ -0,0 +1,109 @@
+package ovh
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableOvhPluginApiCall() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_plugin_api_call",
+		Description: "Last API calls made by the plugin for the connection, to diagnose slow queries.",
+		List: &plugin.ListConfig{
+			Hydrate: listPluginApiCall,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "started_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Start date of the call.",
+			},
+			{
+				Name:        "table_name",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Table"),
+				Description: "Table whose query made the call.",
+			},
+			{
+				Name:        "method",
+				Type:        proto.ColumnType_STRING,
+				Description: "HTTP method of the call.",
+			},
+			{
+				Name:        "route",
+				Type:        proto.ColumnType_STRING,
+				Description: "Route of the call, the path with its identifiers replaced by {id}.",
+			},
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "Path of the call.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_INT,
+				Description: "HTTP status of the response, null if the call failed without a response.",
+			},
+			{
+				Name:        "error",
+				Type:        proto.ColumnType_STRING,
+				Description: "Error of the call when it failed without a response.",
+			},
+			{
+				Name:        "latency_ms",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromMethod("LatencyMs"),
+				Description: "Duration of the call, until its response is read, in milliseconds.",
+			},
+			{
+				Name:        "bytes",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bytes"),
+				Description: "Size of the response body in bytes.",
+			},
+			{
+				Name:        "retry_count",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetryCount"),
+				Description: "Number of previous attempts of the call that were rate limited (429) or failed with a server error (5xx).",
+			},
+			{
+				Name:        "query_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QueryID"),
+				Description: "ID of the call returned by the API (X-Ovh-QueryId), to give to the OVH support.",
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(apiCallTitle),
+				Description: "Title of the resource.",
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromConstant(nil),
+				Description: "A map of tags for the resource, always null as an API call has no tags.",
+			},
+		}),
+	}
+}
+
+func listPluginApiCall(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	for _, call := range apiCalls.list(d.Connection.Name) {
+		d.StreamListItem(ctx, call)
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+	return nil, nil
+}
+
+func apiCallTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	call := d.HydrateItem.(ApiCall)
+	return call.Method + " " + call.Route, nil
+}
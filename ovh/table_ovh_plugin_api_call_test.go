@@ -0,0 +1,138 @@
+package ovh
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+// apiCallsSince returns the API calls of the path made since start, from the oldest to the newest.
+func apiCallsSince(t *testing.T, p *ovhtest.Plugin, path string, start time.Time) []ovhtest.Row {
+	t.Helper()
+	var calls []ovhtest.Row
+	for _, row := range execute(t, p, ovhtest.Query{Table: "ovh_plugin_api_call"}) {
+		if row["path"] == path && !row["started_at"].(time.Time).Before(start) {
+			calls = append(calls, row)
+		}
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i]["started_at"].(time.Time).Before(calls[j]["started_at"].(time.Time))
+	})
+	return calls
+}
+
+func TestPluginApiCallList(t *testing.T) {
+	start := time.Now()
+	_, p := newTestPlugin(t, []string{"ovh_cloud_instance"})
+
+	execute(t, p, ovhtest.Query{Table: "ovh_cloud_instance", Columns: []string{"id"}, Quals: projectQual()})
+	rows := apiCallsSince(t, p, "/cloud/project/p1/instance", start)
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"table_name":  "ovh_cloud_instance",
+		"method":      "GET",
+		"route":       "/cloud/project/{id}/instance",
+		"status":      int64(200),
+		"error":       nil,
+		"retry_count": int64(0),
+		"title":       "GET /cloud/project/{id}/instance",
+		"tags":        nil,
+		"akas":        nil,
+	})
+	if bytes, _ := rows[0]["bytes"].(int64); bytes == 0 {
+		t.Errorf("expected the size of the response, got %v", rows[0]["bytes"])
+	}
+	if latency, ok := rows[0]["latency_ms"].(float64); !ok || latency <= 0 {
+		t.Errorf("expected the latency of the call, got %v", rows[0]["latency_ms"])
+	}
+}
+
+func TestPluginApiCallRetry(t *testing.T) {
+	start := time.Now()
+	server, p := newTestPlugin(t, []string{"ovh_bill"})
+	calls := 0
+	server.HandleFunc(http.MethodGet, "/me/bill", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"Too many requests"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	execute(t, p, ovhtest.Query{Table: "ovh_bill", Columns: []string{"id"}})
+	rows := apiCallsSince(t, p, "/me/bill", start)
+
+	checkRowCount(t, rows, 2)
+	checkRow(t, rows[0], ovhtest.Row{"status": int64(429), "retry_count": int64(0)})
+	checkRow(t, rows[1], ovhtest.Row{"status": int64(200), "retry_count": int64(1), "bytes": int64(2)})
+}
+
+// roundTripFunc is an http.RoundTripper answering with a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestApiCallRetryCountByConnection(t *testing.T) {
+	failing := &apiCallTransport{connection: t.Name() + "-a", table: "ovh_bill", basePath: "/1.0", next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return replayResponse(req, http.StatusServiceUnavailable, nil, nil), nil
+	})}
+	succeeding := &apiCallTransport{connection: t.Name() + "-b", table: "ovh_bill", basePath: "/1.0", next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return replayResponse(req, http.StatusOK, nil, []byte(`[]`)), nil
+	})}
+
+	for _, transport := range []*apiCallTransport{failing, succeeding} {
+		req, err := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/1.0/me/bill", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the same request of another connection is not a retry
+	calls := apiCalls.list(succeeding.connection)
+	if len(calls) != 1 || calls[0].RetryCount != 0 {
+		t.Errorf("expected a call without retry, got %+v", calls)
+	}
+}
+
+func TestApiCallRing(t *testing.T) {
+	ring := newApiCallRing(2)
+	ring.add(ApiCall{Connection: "a", Path: "/1"}, "1")
+	ring.add(ApiCall{Connection: "b", Path: "/2"}, "2")
+	ring.add(ApiCall{Connection: "a", Path: "/3", Status: 503}, "3")
+	ring.add(ApiCall{Connection: "a", Path: "/3", Status: 200}, "3")
+
+	calls := ring.list("a")
+	if len(calls) != 2 || calls[0].Path != "/3" || calls[0].RetryCount != 0 || calls[1].RetryCount != 1 {
+		t.Errorf("unexpected calls %+v", calls)
+	}
+	if calls := ring.list("b"); len(calls) != 0 {
+		t.Errorf("expected the oldest call to be dropped, got %+v", calls)
+	}
+}
+
+func TestApiRoute(t *testing.T) {
+	tests := map[string]string{
+		"/1.0/cloud/project/27c5a6d3dfez87893jfd88fdsfmvnqb8/instance": "/cloud/project/{id}/instance",
+		"/1.0/cloud/project/p1/region/GRA/storage":                     "/cloud/project/{id}/region/{id}/storage",
+		"/1.0/me/bill/FR0001,FR0002/details":                           "/me/bill/{id}/details",
+		"/1.0/dedicated/server/ns1.ip-192-0-2.eu":                      "/dedicated/server/{id}",
+		"/1.0/auth/currentCredential":                                  "/auth/currentCredential",
+		"/v2/iam/resource":                                             "/v2/iam/resource",
+	}
+	for path, expected := range tests {
+		if route := apiRoute(apiPath("/1.0", path)); route != expected {
+			t.Errorf("%s: expected the route %s, got %s", path, expected, route)
+		}
+	}
+}
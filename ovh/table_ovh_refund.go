@@ -23,21 +23,24 @@ type Refund struct {
 	Tax             Price     `json:"tax"`
 }
 
+func (refund Refund) urn(b urnBuilder) string {
+	return b.accountResource("refund/" + refund.ID)
+}
+
 func tableOvhRefund() *plugin.Table {
 	return &plugin.Table{
 		Name:        "ovh_refund",
 		Description: "Refunds of your account.",
 		List: &plugin.ListConfig{
-			Hydrate: listRefund,
+			KeyColumns: dateRangeKeyColumns("date"),
+			Hydrate:    listRefund,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getRefund,
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getRefund,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getRefundInfo},
-		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -45,115 +48,148 @@ func tableOvhRefund() *plugin.Table {
 			},
 			{
 				Name:        "date",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Date of the refund.",
 			},
 			{
 				Name:        "url",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRefundDownload,
 				Transform:   transform.FromField("Url"),
 				Description: "URL to download the refund document.",
 			},
 			{
 				Name:        "pdf_url",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRefundDownload,
 				Transform:   transform.FromField("PdfUrl"),
 				Description: "URL to download the refund document in PDF format (maybe same as url field).",
 			},
 			{
 				Name:        "order_id",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("OrderId"),
 				Description: "Order id.",
 			},
 			{
 				Name:        "original_bill_id",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("OriginalBillId"),
 				Description: "Original Bill id.",
 			},
 			{
 				Name:        "password",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRefundDownload,
 				Description: "Password to download the refund document.",
 			},
 			{
 				Name:        "price_with_tax",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("PriceWithTax.Value"),
 				Description: "Price with tax.",
 			},
 			{
 				Name:        "price_without_tax",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("PriceWithoutTax.Value"),
 				Description: "Price without tax.",
 			},
 			{
 				Name:        "tax",
-				Hydrate:     getRefundInfo,
 				Type:        proto.ColumnType_DOUBLE,
 				Transform:   transform.FromField("Tax.Value"),
 				Description: "Amount of the tax.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
-func getRefundInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	refund := h.Item.(Refund)
-
+func listRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_refund.getRefundInfo", "connection_error", err)
+		plugin.Logger(ctx).Error("ovh_refund.listRefund", "connection_error", err)
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/me/refund/%s", refund.ID), &refund)
+	var refundsId []string
+	err = client.Get("/me/refund"+dateRangeQuery(d, "date"), &refundsId)
 
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill.getRefundInfo", err)
+		plugin.Logger(ctx).Error("ovh_refund.listRefund", err)
 		return nil, err
 	}
 
-	return refund, nil
+	cache, err := getBillingCache(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund.listRefund", "cache_error", err)
+		return nil, err
+	}
+	err = getBatchedCached(ctx, d, client, cache, "/me/refund", refundsId, func(refund Refund) {
+		d.StreamListItem(ctx, refund)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund.listRefund", err)
+		return nil, err
+	}
+
+	return nil, nil
 }
 
-func listRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_refund.listRefund", "connection_error", err)
+		plugin.Logger(ctx).Error("ovh_refund.getRefund", "connection_error", err)
 		return nil, err
 	}
 
-	var refundsId []string
-	err = client.Get("/me/refund", &refundsId)
-
+	cache, err := getBillingCache(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_refund.listRefund", err)
+		plugin.Logger(ctx).Error("ovh_refund.getRefund", "cache_error", err)
 		return nil, err
 	}
 
-	for _, refundId := range refundsId {
-		var refund Refund
-		refund.ID = refundId
-		d.StreamListItem(ctx, refund)
+	id := d.EqualsQuals["id"].GetStringValue()
+	refund, err := getCached[Refund](ctx, client, cache, fmt.Sprintf("/me/refund/%s", id))
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund.getRefund", err)
+		return nil, err
 	}
 
-	return nil, nil
+	return refund, nil
 }
 
-func getRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	id := d.Quals.ToEqualsQualValueMap()["id"].GetStringValue()
-	var refund Refund
-	refund.ID = id
+// getRefundDownload returns the refund with its download links and password, they are not stored
+// in the billing cache and are fetched from the API for the cached refunds.
+func getRefundDownload(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	refund := h.Item.(Refund)
+	if refund.Url != "" {
+		return refund, nil
+	}
+	// the download links are not recorded, they are null in replay mode
+	if stringValue(GetConfig(d.Connection).ReplayDir) != "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund.getRefundDownload", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.GetWithContext(ctx, fmt.Sprintf("/me/refund/%s", refund.ID), &refund)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund.getRefundDownload", err)
+		return nil, err
+	}
+
 	return refund, nil
 }
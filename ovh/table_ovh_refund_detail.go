@@ -23,6 +23,10 @@ type RefundDetail struct {
 	UnitPrice   RefundPrice `json:"unitPrice"`
 }
 
+func (refundDetail RefundDetail) urn(b urnBuilder) string {
+	return b.accountResource("refund/" + refundDetail.RefundID + "/details/" + refundDetail.ID)
+}
+
 func tableOvhRefundDetails() *plugin.Table {
 	return &plugin.Table{
 		Name:        "ovh_refund_detail",
@@ -30,12 +34,15 @@ func tableOvhRefundDetails() *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.AllColumns([]string{"refund_id"}),
 			Hydrate:    listRefundDetails,
+			// a missing refund has no details
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"refund_id", "id"}),
-			Hydrate:    getRefundDetail,
+			KeyColumns:   plugin.AllColumns([]string{"refund_id", "id"}),
+			Hydrate:      getRefundDetail,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -79,7 +86,16 @@ func tableOvhRefundDetails() *plugin.Table {
 				Transform:   transform.FromField("UnitPrice.Value"),
 				Description: "Unit price of this detail.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGetRefundDetailInfo,
+				Transform:   transform.FromField("Description"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -94,14 +110,21 @@ func getGetRefundDetailInfo(ctx context.Context, d *plugin.QueryData, h *plugin.
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/me/refund/%s/details/%s", refundDetail.RefundID, refundDetail.ID), &refundDetail)
+	cache, err := getBillingCache(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund_detail.getGetBillDetailInfo", "cache_error", err)
+		return nil, err
+	}
+
+	result, err := getCached[RefundDetail](ctx, client, cache, fmt.Sprintf("/me/refund/%s/details/%s", refundDetail.RefundID, refundDetail.ID))
 
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_refund_detail.getGetBillDetailInfo", err)
 		return nil, err
 	}
+	result.RefundID = refundDetail.RefundID
 
-	return refundDetail, nil
+	return result, nil
 }
 
 func listRefundDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -113,9 +136,14 @@ func listRefundDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 	refundId := d.EqualsQuals["refund_id"].GetStringValue()
 
-	// First, we get IDs of refund
-	var refundDetailsId []string
-	err = client.Get(fmt.Sprintf("/me/refund/%s/details", refundId), &refundDetailsId)
+	cache, err := getBillingCache(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_refund_detail.listRefundDetails", "cache_error", err)
+		return nil, err
+	}
+
+	// First, we get IDs of refund, they never change once the refund is issued
+	refundDetailsId, err := getCached[[]string](ctx, client, cache, fmt.Sprintf("/me/refund/%s/details", refundId))
 
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_refund_detail.listRefundDetails", err)
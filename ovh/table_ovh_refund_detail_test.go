@@ -0,0 +1,54 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestRefundDetailList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_refund_detail"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_refund_detail", Quals: []ovhtest.Qual{ovhtest.Equals("refund_id", "AFR01")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":          "RD1",
+		"refund_id":   "AFR01",
+		"description": "Public Cloud",
+		"domain":      "p1",
+		"quantity":    "1",
+		"total_price": -10.0,
+		"unit_price":  -10.0,
+	})
+}
+
+func TestRefundDetailGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_refund_detail"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_refund_detail", Quals: []ovhtest.Qual{ovhtest.Equals("refund_id", "AFR01"), ovhtest.Equals("id", "RD1")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "RD1", "description": "Public Cloud"})
+}
+
+func TestRefundDetailListCache(t *testing.T) {
+	config := fmt.Sprintf("billing_cache_dir = %q", t.TempDir())
+	query := ovhtest.Query{Table: "ovh_refund_detail", Columns: []string{"id", "description"}, Quals: []ovhtest.Qual{ovhtest.Equals("refund_id", "AFR01")}}
+	_, p := newTestPlugin(t, []string{"ovh_refund_detail"}, config)
+	execute(t, p, query)
+
+	// the details and their list are read from the cache
+	server, p := newTestPlugin(t, nil, config)
+	rows := execute(t, p, query)
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "RD1", "description": "Public Cloud"})
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET /me/refund") {
+			t.Errorf("unexpected request of a cached refund detail: %s", request)
+		}
+	}
+}
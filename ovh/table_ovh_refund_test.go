@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestRefundList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_refund"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_refund"})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"id":                "AFR01",
+		"date":              mustParseTime(t, "2024-03-01T00:00:00+01:00"),
+		"pdf_url":           "https://www.ovh.com/cgi-bin/order/refund.pdf?reference=AFR01",
+		"order_id":          int64(2001),
+		"original_bill_id":  "FR0001",
+		"password":          "secret",
+		"price_with_tax":    -12.0,
+		"price_without_tax": -10.0,
+		"tax":               -2.0,
+	})
+}
+
+func TestRefundGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_refund"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_refund", Quals: []ovhtest.Qual{ovhtest.Equals("id", "AFR01")}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "AFR01", "original_bill_id": "FR0001"})
+}
+
+func TestRefundListDate(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_refund"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_refund", Columns: []string{"id"}, Quals: []ovhtest.Qual{
+		ovhtest.Equals("date", mustParseTime(t, "2024-03-01T00:00:00+01:00")),
+	}})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"id": "AFR01"})
+	expected := "GET /me/refund?date.from=2024-02-29T23%3A00%3A00Z&date.to=2024-02-29T23%3A00%3A00Z"
+	if requests := server.Requests(); len(requests) < 2 || requests[1] != expected {
+		t.Errorf("expected the request %s, got %v", expected, requests)
+	}
+}
@@ -2,8 +2,9 @@ package ovh
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -37,6 +38,10 @@ type SavingsPlan struct {
 	ServiceIDNum    int             `json:"-"`               // Set by hydrate function
 }
 
+func (savingsPlan SavingsPlan) urn(b urnBuilder) string {
+	return b.projectResource(savingsPlan.ProjectID, "savingsPlans/"+savingsPlan.ID)
+}
+
 func tableOvhSavingsPlanSubscribed() *plugin.Table {
 	return &plugin.Table{
 		Name:        "ovh_savings_plan_subscribed",
@@ -48,10 +53,11 @@ func tableOvhSavingsPlanSubscribed() *plugin.Table {
 			},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "savings_plan_id"}),
-			Hydrate:    getOvhSavingsPlanSubscribed,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "savings_plan_id"}),
+			Hydrate:      getOvhSavingsPlanSubscribed,
+			IgnoreConfig: notFoundIgnoreConfig(),
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
@@ -145,7 +151,15 @@ func tableOvhSavingsPlanSubscribed() *plugin.Table {
 				Transform:   transform.FromField("PlannedChanges"),
 				Description: "Changes planned on the Savings Plan.",
 			},
-		},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+				Description: "Title of the resource.",
+			},
+			nullTagsColumn(),
+		}),
 	}
 }
 
@@ -170,6 +184,9 @@ func getServiceIDFromProjectID(ctx context.Context, client *ovh.Client, projectI
 
 func listOvhSavingsPlanSubscribed(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	projectID := d.EqualsQuals["project_id"].GetStringValue()
+	if !projectIncluded(d, projectID) {
+		return nil, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -192,7 +209,8 @@ func listOvhSavingsPlanSubscribed(ctx context.Context, d *plugin.QueryData, h *p
 	err = client.Get(fmt.Sprintf("/services/%d/savingsPlans/subscribed", serviceID), &savingsPlans)
 	if err != nil {
 		// If we get a JSON unmarshal error, it might be that the API returned string IDs instead of objects
-		if strings.Contains(err.Error(), "cannot unmarshal") {
+		var unmarshalError *json.UnmarshalTypeError
+		if errors.As(err, &unmarshalError) {
 			// Try to get as string array (fallback)
 			var savingsPlanIDs []string
 			err2 := client.Get(fmt.Sprintf("/services/%d/savingsPlans/subscribed", serviceID), &savingsPlanIDs)
@@ -219,8 +237,8 @@ func listOvhSavingsPlanSubscribed(ctx context.Context, d *plugin.QueryData, h *p
 			return nil, nil
 		}
 
-		// If the API returns 404 or similar, it means no savings plans exist or the service doesn't support them
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		// If the API returns 404, it means no savings plans exist or the service doesn't support them
+		if isNotFoundError(err) {
 			return nil, nil // Return empty result instead of error
 		}
 		plugin.Logger(ctx).Error("ovh_savings_plan_subscribed.listOvhSavingsPlanSubscribed", err)
@@ -241,6 +259,9 @@ func listOvhSavingsPlanSubscribed(ctx context.Context, d *plugin.QueryData, h *p
 
 func getOvhSavingsPlanSubscribed(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	projectID := d.EqualsQuals["project_id"].GetStringValue()
+	if !projectIncluded(d, projectID) {
+		return nil, nil
+	}
 	savingsPlanID := d.EqualsQuals["savings_plan_id"].GetStringValue()
 
 	client, err := connect(ctx, d)
@@ -258,7 +279,7 @@ func getOvhSavingsPlanSubscribed(ctx context.Context, d *plugin.QueryData, h *pl
 	var savingsPlan SavingsPlan
 	err = client.Get(fmt.Sprintf("/services/%d/savingsPlans/subscribed/%s", serviceID, savingsPlanID), &savingsPlan)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if isNotFoundError(err) {
 			return nil, nil
 		}
 		plugin.Logger(ctx).Error("ovh_savings_plan_subscribed.getOvhSavingsPlanSubscribed", err)
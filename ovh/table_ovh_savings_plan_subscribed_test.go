@@ -0,0 +1,66 @@
+package ovh
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+)
+
+func TestSavingsPlanSubscribedList(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_savings_plan_subscribed"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_savings_plan_subscribed", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{
+		"project_id":        "p1",
+		"service_id":        int64(987),
+		"savings_plan_id":   "sp-1",
+		"display_name":      "b3-8 plan",
+		"status":            "ACTIVE",
+		"size":              int64(2),
+		"flavor":            "b3-8",
+		"period":            "P1M",
+		"offer_id":          "offer-1",
+		"period_end_action": "REACTIVATE",
+		"termination_date":  nil,
+		"planned_changes":   []interface{}{map[string]interface{}{"plannedOn": "2024-02-01", "properties": map[string]interface{}{"size": 3.0}}},
+	})
+}
+
+func TestSavingsPlanSubscribedListIds(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_savings_plan_subscribed"})
+	server.Handle(http.MethodGet, "/services/987/savingsPlans/subscribed", http.StatusOK, []string{"sp-1"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_savings_plan_subscribed", Quals: projectQual()})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"savings_plan_id": "sp-1", "flavor": "b3-8"})
+}
+
+func TestSavingsPlanSubscribedGet(t *testing.T) {
+	_, p := newTestPlugin(t, []string{"ovh_savings_plan_subscribed"})
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_savings_plan_subscribed", Quals: append(projectQual(), ovhtest.Equals("savings_plan_id", "sp-1"))})
+
+	checkRowCount(t, rows, 1)
+	checkRow(t, rows[0], ovhtest.Row{"savings_plan_id": "sp-1", "service_id": int64(987)})
+}
+
+func TestSavingsPlanSubscribedExcludedProject(t *testing.T) {
+	server, p := newTestPlugin(t, []string{"ovh_savings_plan_subscribed"}, `projects = ["*", "!p1"]`)
+
+	rows := execute(t, p, ovhtest.Query{Table: "ovh_savings_plan_subscribed", Quals: projectQual()})
+	checkRowCount(t, rows, 0)
+
+	rows = execute(t, p, ovhtest.Query{Table: "ovh_savings_plan_subscribed", Quals: append(projectQual(), ovhtest.Equals("savings_plan_id", "sp-1"))})
+	checkRowCount(t, rows, 0)
+
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET /cloud/project/p1") || strings.HasPrefix(request, "GET /services/") {
+			t.Errorf("unexpected request to the excluded project: %s", request)
+		}
+	}
+}
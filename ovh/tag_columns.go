@@ -0,0 +1,90 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tagColumnTables are the tables with the IAM tags of their resources in their tags column,
+// they get a column for each key of 'tag_columns'.
+var tagColumnTables = []string{
+	"ovh_ceph",
+	"ovh_cloud_project",
+	"ovh_dedicated_server",
+	"ovh_iam_resource",
+}
+
+var invalidColumnCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// tagColumnName returns the column of a tag key, tag_ followed by the key in snake case.
+func tagColumnName(key string) string {
+	return "tag_" + strings.Trim(invalidColumnCharacters.ReplaceAllString(strings.ToLower(key), "_"), "_")
+}
+
+// addTagColumns replaces the tables with the tags column by a copy with a column for each tag key,
+// the value of the tag or null if the resource does not have it.
+func addTagColumns(tables map[string]*plugin.Table, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	keysByColumn := map[string]string{}
+	for _, key := range keys {
+		column := tagColumnName(key)
+		if column == "tag_" {
+			return fmt.Errorf("invalid tag key %q in 'tag_columns'. Edit your connection configuration file and then restart Steampipe", key)
+		}
+		if other, ok := keysByColumn[column]; ok {
+			return fmt.Errorf("the tag keys %q and %q of 'tag_columns' have the same column %s. Edit your connection configuration file and then restart Steampipe", other, key, column)
+		}
+		keysByColumn[column] = key
+	}
+
+	for _, name := range tagColumnTables {
+		table, ok := tables[name]
+		if !ok {
+			continue
+		}
+		i := slices.IndexFunc(table.Columns, func(column *plugin.Column) bool {
+			return column.Name == "tags"
+		})
+		if i < 0 {
+			continue
+		}
+		tags := table.Columns[i]
+
+		// the tables are shared by the connections, they are copied before adding the columns
+		tableWithTags := *table
+		tableWithTags.Columns = slices.Clone(table.Columns)
+		for _, key := range keys {
+			tableWithTags.Columns = append(tableWithTags.Columns, &plugin.Column{
+				Name:        tagColumnName(key),
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     tags.Hydrate,
+				Transform:   (&transform.ColumnTransforms{Transforms: slices.Clone(tags.Transform.Transforms)}).TransformP(tagValue, key),
+				Description: fmt.Sprintf("Value of the %s tag, null if the resource does not have it.", key),
+			})
+		}
+		tables[name] = &tableWithTags
+	}
+	return nil
+}
+
+// tagValue returns the value of the tag key (the param) of the tags map.
+func tagValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.(map[string]string)
+	if !ok {
+		return nil, nil
+	}
+	value, ok := tags[d.Param.(string)]
+	if !ok {
+		return nil, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,78 @@
+package ovh
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/francois2metz/steampipe-plugin-ovh/ovh/ovhtest"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const tagColumnsConfig = `tag_columns = ["env", "team", "cost-center"]`
+
+func TestTagColumnsSchema(t *testing.T) {
+	_, p := newTestPlugin(t, nil, tagColumnsConfig)
+
+	for _, table := range tagColumnTables {
+		columns := p.Columns(table)
+		for _, column := range []string{"tag_env", "tag_team", "tag_cost_center"} {
+			if !slices.Contains(columns, column) {
+				t.Errorf("table %s: expected the column %s, got %v", table, column, columns)
+			}
+		}
+	}
+	if columns := p.Columns("ovh_bill"); slices.Contains(columns, "tag_env") {
+		t.Errorf("unexpected tag column on ovh_bill: %v", columns)
+	}
+
+	// the columns are removed with the option
+	_, p = newTestPlugin(t, nil)
+	if columns := p.Columns("ovh_ceph"); slices.Contains(columns, "tag_env") {
+		t.Errorf("unexpected tag column without tag_columns: %v", columns)
+	}
+}
+
+func TestTagColumnsValues(t *testing.T) {
+	tests := []struct {
+		table    string
+		quals    []ovhtest.Qual
+		rowCount int
+		expected ovhtest.Row
+	}{
+		{"ovh_ceph", nil, 1, ovhtest.Row{"tag_env": "prod", "tag_team": nil}},
+		{"ovh_cloud_project", []ovhtest.Qual{ovhtest.Equals("id", "p1")}, 1, ovhtest.Row{"tag_env": "prod", "tag_team": nil}},
+		{"ovh_dedicated_server", []ovhtest.Qual{ovhtest.Equals("name", "ns1.ip-192-0-2.eu")}, 1, ovhtest.Row{"tag_env": "prod", "tag_team": "infra"}},
+		// the untagged resource is first
+		{"ovh_iam_resource", nil, 2, ovhtest.Row{"tag_env": "prod", "tag_team": nil}},
+	}
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			_, p := newTestPlugin(t, []string{test.table}, tagColumnsConfig)
+
+			rows := sortRows(execute(t, p, ovhtest.Query{Table: test.table, Columns: []string{"tag_env", "tag_team", "tag_cost_center"}, Quals: test.quals}), "tag_env")
+
+			checkRowCount(t, rows, test.rowCount)
+			checkRow(t, rows[test.rowCount-1], test.expected)
+			checkRow(t, rows[test.rowCount-1], ovhtest.Row{"tag_cost_center": nil})
+		})
+	}
+}
+
+func TestTagColumnsConfigErrors(t *testing.T) {
+	tests := map[string][]string{
+		`invalid tag key "--"`:                 {"--"},
+		`"cost-center" and "cost_center"`:      {"cost-center", "cost_center"},
+		`have the same column tag_cost_center`: {"Cost Center", "cost-center"},
+	}
+	for expected, keys := range tests {
+		d := &plugin.TableMapData{Connection: &plugin.Connection{Config: ovhConfig{TagColumns: keys}}}
+
+		_, err := pluginTableMap(context.Background(), d, Plugin(context.Background()).TableMap)
+
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%v: expected an error containing %q, got %v", keys, expected, err)
+		}
+	}
+}
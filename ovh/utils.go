@@ -2,61 +2,541 @@ package ovh
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"golang.org/x/sync/errgroup"
 )
 
 func connect(ctx context.Context, d *plugin.QueryData) (*ovh.Client, error) {
-	// get ovh client from cache
-	cacheKey := "ovh"
+	// each table has its own copy of the client of the connection, to record its API calls
+	cacheKey := fmt.Sprintf("ovh-%s-%s", d.Connection.Name, d.Table.Name)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(*ovh.Client), nil
 	}
+	client, err := connectionClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	endpointUrl, err := url.Parse(client.Endpoint())
+	if err != nil {
+		return nil, err
+	}
+	// the client of the connection is not used once created, it can be copied
+	tableClient := *client
+	httpClient := *client.Client
+	// each retry of a request is recorded as an API call
+	httpClient.Transport = &retryTransport{next: &apiCallTransport{
+		connection: d.Connection.Name,
+		table:      d.Table.Name,
+		basePath:   endpointUrl.Path,
+		next:       transportOrDefault(client.Client.Transport),
+	}}
+	tableClient.Client = &httpClient
+
+	d.ConnectionManager.Cache.Set(cacheKey, &tableClient)
+	return &tableClient, nil
+}
 
-	applicationKey := ""
-	applicationSecret := ""
-	consumerKey := ""
-	endpoint := ""
+// connectionClient returns the client of the connection.
+func connectionClient(ctx context.Context, d *plugin.QueryData) (*ovh.Client, error) {
+	// get ovh client from cache, each connection has its own credentials
+	cacheKey := fmt.Sprintf("ovh-%s", d.Connection.Name)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*ovh.Client), nil
+	}
 
 	ovhConfig := GetConfig(d.Connection)
 
-	if ovhConfig.ApplicationKey != nil {
-		applicationKey = *ovhConfig.ApplicationKey
-	}
-	if ovhConfig.ApplicationSecret != nil {
-		applicationSecret = *ovhConfig.ApplicationSecret
+	// Settings not set in the connection configuration are read from the
+	// environment, then from the ovh.conf files
+	ovhConf, err := loadOvhConf()
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh.connect", "config_error", err)
+		return nil, err
 	}
-	if ovhConfig.ConsumerKey != nil {
-		consumerKey = *ovhConfig.ConsumerKey
+
+	endpoint := getConfigValue(ovhConfig.Endpoint, ovhConf, "default", "endpoint")
+
+	if err := validateFilters("projects", ovhConfig.Projects); err != nil {
+		return nil, err
 	}
-	if ovhConfig.Endpoint != nil {
-		endpoint = *ovhConfig.Endpoint
+	if err := validateFilters("regions", ovhConfig.Regions); err != nil {
+		return nil, err
 	}
 
-	if applicationKey == "" {
-		return nil, errors.New("'application_key' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	recordDir := stringValue(ovhConfig.RecordDir)
+	replayDir := stringValue(ovhConfig.ReplayDir)
+	if recordDir != "" && replayDir != "" {
+		return nil, errors.New("'record_dir' and 'replay_dir' cannot be used together. Edit your connection configuration file and then restart Steampipe")
 	}
-	if applicationSecret == "" {
-		return nil, errors.New("'application_secret' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	if replayDir != "" {
+		client, err := newReplayClient(endpoint, replayDir)
+		if err != nil {
+			plugin.Logger(ctx).Error("ovh.connect", "client_error", err)
+			return nil, err
+		}
+		d.ConnectionManager.Cache.Set(cacheKey, client)
+		return client, nil
 	}
-	if consumerKey == "" {
-		return nil, errors.New("'consumer_key' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+
+	authSettings, useOAuth2, err := getAuthSettings(map[string]*string{
+		"application_key":    ovhConfig.ApplicationKey,
+		"application_secret": ovhConfig.ApplicationSecret,
+		"consumer_key":       ovhConfig.ConsumerKey,
+		"client_id":          ovhConfig.ClientId,
+		"client_secret":      ovhConfig.ClientSecret,
+	}, ovhConf, endpoint)
+	if err != nil {
+		return nil, err
 	}
+	applicationKey := authSettings["application_key"]
+	applicationSecret := authSettings["application_secret"]
+	consumerKey := authSettings["consumer_key"]
+	clientId := authSettings["client_id"]
+	clientSecret := authSettings["client_secret"]
+
 	if endpoint == "" {
-		return nil, errors.New("'endpoint' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		return nil, errors.New("'endpoint' must be set in the connection configuration, the OVH_ENDPOINT environment variable or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
 	}
 
-	client, _ := ovh.NewClient(
-		endpoint,
-		applicationKey,
-		applicationSecret,
-		consumerKey,
-	)
+	var client *ovh.Client
+	if useOAuth2 {
+		if clientId == "" {
+			return nil, errors.New("'client_id' must be set in the connection configuration, the OVH_CLIENT_ID environment variable or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
+		}
+		if clientSecret == "" {
+			return nil, errors.New("'client_secret' must be set in the connection configuration, the OVH_CLIENT_SECRET environment variable or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
+		}
+
+		client, err = ovh.NewOAuth2Client(
+			endpoint,
+			clientId,
+			clientSecret,
+		)
+	} else {
+		if applicationKey == "" {
+			return nil, errors.New("'application_key' must be set in the connection configuration, the OVH_APPLICATION_KEY environment variable or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
+		}
+		if applicationSecret == "" {
+			return nil, errors.New("'application_secret' must be set in the connection configuration, the OVH_APPLICATION_SECRET environment variable or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
+		}
+		if consumerKey == "" {
+			return nil, errors.New("'consumer_key' must be set in the connection configuration, the OVH_CONSUMER_KEY environment variable or an ovh.conf file. Edit your connection configuration file and then restart Steampipe")
+		}
+
+		client, err = ovh.NewClient(
+			endpoint,
+			applicationKey,
+			applicationSecret,
+			consumerKey,
+		)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh.connect", "client_error", err)
+		return nil, err
+	}
+	if recordDir != "" {
+		client.Client.Transport = &recordTransport{dir: recordDir, next: http.DefaultTransport}
+	}
+
+	// the consumer key is checked once, the OAuth2 client credentials are checked when getting a token
+	if !useOAuth2 {
+		endpointUrl, err := url.Parse(client.Endpoint())
+		if err != nil {
+			return nil, err
+		}
+		next := transportOrDefault(client.Client.Transport)
+		// the calls of the validation are recorded for the table that opens the connection
+		client.Client.Transport = &apiCallTransport{
+			connection: d.Connection.Name,
+			table:      d.Table.Name,
+			basePath:   endpointUrl.Path,
+			next:       next,
+		}
+		credential, err := getCurrentCredential(ctx, client)
+		client.Client.Transport = next
+		if err != nil {
+			// the consumer key may not be allowed to get its credential, the API errors are then not explained
+			plugin.Logger(ctx).Warn("ovh.connect", "credential_error", err)
+		} else {
+			if err := validateCredential(credential); err != nil {
+				plugin.Logger(ctx).Error("ovh.connect", "credential_error", err)
+				return nil, err
+			}
+			client.Client.Transport = &accessRulesTransport{rules: credential.Rules, basePath: endpointUrl.Path, next: next}
+		}
+	}
 
 	// Save to cache
 	d.ConnectionManager.Cache.Set(cacheKey, client)
 
 	return client, nil
 }
+
+// newReplayClient returns a client answering with the recordings of the directory,
+// the credentials are not needed as no request is sent to the API.
+func newReplayClient(endpoint, dir string) (*ovh.Client, error) {
+	if endpoint == "" {
+		endpoint = ovh.OvhEU
+	}
+	client, err := ovh.NewClient(endpoint, redacted, redacted, redacted)
+	if err != nil {
+		return nil, err
+	}
+	client.Client.Transport = &replayTransport{dir: dir}
+	return client, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func transportOrDefault(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		return http.DefaultTransport
+	}
+	return transport
+}
+
+// commonColumns adds the columns shared by every table to the given columns.
+func commonColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns,
+		&plugin.Column{
+			Name:        "akas",
+			Type:        proto.ColumnType_JSON,
+			Hydrate:     getAkas,
+			Transform:   transform.FromValue(),
+			Description: "Array of globally unique identifier strings (also known as) for the resource.",
+		},
+		&plugin.Column{
+			Name:        "account",
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     getAccount,
+			Transform:   transform.FromValue(),
+			Description: "The OVH account (nichandle) of the connection.",
+		},
+	)
+}
+
+// nullTagsColumn is the tags column of the tables of resources without tags in the OVH API.
+func nullTagsColumn() *plugin.Column {
+	return &plugin.Column{
+		Name:        "tags",
+		Type:        proto.ColumnType_JSON,
+		Transform:   transform.FromConstant(nil),
+		Description: "A map of tags for the resource, always null as the OVH API has no tags for this resource.",
+	}
+}
+
+// iamResource is implemented by the rows of every table, it returns the IAM URN of the row.
+type iamResource interface {
+	urn(b urnBuilder) string
+}
+
+// urnBuilder builds the IAM URNs of the resources of a connection,
+// for the resources whose URN is not returned by the API.
+type urnBuilder struct {
+	region  string
+	account string
+}
+
+// resource returns the URN of a resource, urn:v1:<region>:resource:<type>:<id>.
+func (b urnBuilder) resource(resourceType, id string) string {
+	return fmt.Sprintf("urn:v1:%s:resource:%s:%s", b.region, resourceType, id)
+}
+
+// accountResource returns the URN of a resource of the account, identified by its API path below /me.
+func (b urnBuilder) accountResource(path string) string {
+	return b.resource("account", b.account+"/"+path)
+}
+
+// projectResource returns the URN of a resource of a cloud project, identified by its API path below the project.
+func (b urnBuilder) projectResource(projectId, path string) string {
+	return b.resource("publicCloudProject", projectId+"/"+path)
+}
+
+// urnRegion returns the region of the IAM URNs of an API endpoint: eu, ca or us.
+func urnRegion(endpoint string) string {
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil {
+		host = u.Hostname()
+	}
+	switch {
+	case strings.HasPrefix(host, "ca."):
+		return "ca"
+	case strings.HasPrefix(host, "us.") || strings.Contains(host, ".us."):
+		return "us"
+	}
+	return "eu"
+}
+
+// getAkas returns the IAM URN of the row, as returned by the API or built from the API path of the resource.
+func getAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resource, ok := h.Item.(iamResource)
+	if !ok {
+		return nil, nil
+	}
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh.getAkas", "connection_error", err)
+		return nil, err
+	}
+	account, err := getAccount(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh.getAkas", err)
+		return nil, err
+	}
+	b := urnBuilder{region: urnRegion(client.Endpoint()), account: account.(string)}
+	return []string{resource.urn(b)}, nil
+}
+
+type Me struct {
+	Nichandle string `json:"nichandle"`
+}
+
+// getAccount returns the nichandle of the connection, it is only fetched once per connection.
+func getAccount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getAccountMemoized(ctx, d, h)
+}
+
+var getAccountMemoized = plugin.HydrateFunc(getAccountUncached).Memoize()
+
+func getAccountUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh.getAccount", "connection_error", err)
+		return nil, err
+	}
+
+	var me Me
+	err = client.Get("/me", &me)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh.getAccount", err)
+		return nil, err
+	}
+
+	return me.Nichandle, nil
+}
+
+// notFoundIgnoreConfig is the ignore config of the get hydrates, so that getting a missing object returns no row.
+func notFoundIgnoreConfig() *plugin.IgnoreConfig {
+	return &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: shouldIgnoreError}
+}
+
+// shouldIgnoreError ignores the not found (404) errors of the OVH API, so a missing object returns no row.
+func shouldIgnoreError(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+	return isNotFoundError(err)
+}
+
+func isNotFoundError(err error) bool {
+	var apiError *ovh.APIError
+	return errors.As(err, &apiError) && apiError.Code == http.StatusNotFound
+}
+
+// dateRangeKeyColumns are the optional quals on a date column pushed down with dateRangeQuery.
+func dateRangeKeyColumns(column string) plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{Name: column, Operators: []string{">", ">=", "<", "<=", "="}, Require: plugin.Optional},
+	}
+}
+
+// dateRangeQuery returns the date.from and date.to query parameters of the quals on the date column.
+// The API filters are inclusive, the strict operators are applied by Steampipe on the returned rows.
+func dateRangeQuery(d *plugin.QueryData, column string) string {
+	var from, to time.Time
+	if quals := d.Quals[column]; quals != nil {
+		for _, qual := range quals.Quals {
+			value := qual.Value.GetTimestampValue().AsTime()
+			if qual.Operator == ">" || qual.Operator == ">=" || qual.Operator == "=" {
+				if from.IsZero() || value.After(from) {
+					from = value
+				}
+			}
+			if qual.Operator == "<" || qual.Operator == "<=" || qual.Operator == "=" {
+				if to.IsZero() || value.Before(to) {
+					to = value
+				}
+			}
+		}
+	}
+
+	params := url.Values{}
+	if !from.IsZero() {
+		params.Set("date.from", from.UTC().Truncate(time.Second).Format(time.RFC3339))
+	}
+	if !to.IsZero() {
+		// rounded up to the second, the API dates have no fractional seconds
+		params.Set("date.to", to.UTC().Add(time.Second-1).Truncate(time.Second).Format(time.RFC3339))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
+
+// equalsQualsQuery returns the query parameters of the equal quals, params maps the
+// column names to the names of the API query parameters.
+func equalsQualsQuery(d *plugin.QueryData, params map[string]string) string {
+	values := url.Values{}
+	for column, param := range params {
+		if value := d.EqualsQualString(column); value != "" {
+			values.Set(param, value)
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// iamTagsKeyColumn is the optional qual on the IAM tags of a listing pushed down with iamTagsFilter.
+var iamTagsKeyColumn = &plugin.KeyColumn{Name: "tags", Operators: []string{"=", "@>"}, Require: plugin.Optional}
+
+type iamTagCondition struct {
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// iamTagsFilter returns the iamTags filter of the API listings for the quals on the tags
+// column, each string value of the JSON objects of the quals is a condition on its key.
+// The quals are checked again on the returned rows, the other values are ignored.
+func iamTagsFilter(d *plugin.QueryData) string {
+	filter := map[string][]iamTagCondition{}
+	if quals := d.Quals["tags"]; quals != nil {
+		for _, qual := range quals.Quals {
+			var tags map[string]interface{}
+			if err := json.Unmarshal([]byte(qual.Value.GetJsonbValue()), &tags); err != nil {
+				continue
+			}
+			for key, value := range tags {
+				if value, ok := value.(string); ok {
+					filter[key] = []iamTagCondition{{Operator: "EQ", Value: value}}
+				}
+			}
+		}
+	}
+	if len(filter) == 0 {
+		return ""
+	}
+	content, _ := json.Marshal(filter)
+	return string(content)
+}
+
+// maxV2PageSize is the page size requested from the /v2 endpoints when the query has a small limit.
+const maxV2PageSize = 1000
+
+// getV2Paginated calls a paginated /v2 endpoint and calls streamPage for each page,
+// following the X-Pagination-Cursor-Next header until the last page or the query limit is reached.
+func getV2Paginated[T any](ctx context.Context, d *plugin.QueryData, client *ovh.Client, path string, streamPage func([]T)) error {
+	cursor := ""
+	for {
+		req, err := client.NewRequest(http.MethodGet, path, nil, true)
+		if err != nil {
+			return err
+		}
+		if cursor != "" {
+			req.Header.Set("X-Pagination-Cursor", cursor)
+		}
+		if rowsRemaining := d.RowsRemaining(ctx); rowsRemaining < maxV2PageSize {
+			req.Header.Set("X-Pagination-Size", fmt.Sprint(max(rowsRemaining, 1)))
+		}
+
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		cursor = resp.Header.Get("X-Pagination-Cursor-Next")
+
+		var page []T
+		if err := client.UnmarshalResponse(resp, &page); err != nil {
+			return err
+		}
+		streamPage(page)
+
+		if cursor == "" || d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+}
+
+const (
+	defaultBatchSize        = 50
+	defaultBatchConcurrency = 5
+)
+
+type batchResult[T any] struct {
+	Key   string `json:"key"`
+	Value T      `json:"value"`
+	Error string `json:"error"`
+}
+
+// getBatched fetches the objects path/{id} of the given ids with the batch mode of the
+// OVH API (comma separated ids and the X-Ovh-Batch header) and calls streamItem for each object.
+// Batches are fetched concurrently and no new batch is sent once the query limit is reached.
+func getBatched[T any](ctx context.Context, d *plugin.QueryData, client *ovh.Client, path string, ids []string, streamItem func(T)) error {
+	return getBatchedByKey(ctx, d, client, path, ids, func(_ string, item T) {
+		streamItem(item)
+	})
+}
+
+// getBatchedByKey is getBatched for the objects without their id, streamItem gets the id of each object.
+func getBatchedByKey[T any](ctx context.Context, d *plugin.QueryData, client *ovh.Client, path string, ids []string, streamItem func(string, T)) error {
+	batchSize := defaultBatchSize
+	batchConcurrency := defaultBatchConcurrency
+	ovhConfig := GetConfig(d.Connection)
+	if ovhConfig.BatchSize != nil && *ovhConfig.BatchSize > 0 {
+		batchSize = *ovhConfig.BatchSize
+	}
+	if ovhConfig.BatchConcurrency != nil && *ovhConfig.BatchConcurrency > 0 {
+		batchConcurrency = *ovhConfig.BatchConcurrency
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(batchConcurrency)
+	for start := 0; start < len(ids); start += batchSize {
+		if d.RowsRemaining(ctx) == 0 || gctx.Err() != nil {
+			break
+		}
+		batch := ids[start:min(start+batchSize, len(ids))]
+		g.Go(func() error {
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", path, strings.Join(batch, ",")), nil, true)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("X-Ovh-Batch", ",")
+			resp, err := client.Do(req.WithContext(gctx))
+			if err != nil {
+				return err
+			}
+			var results []batchResult[T]
+			if err := client.UnmarshalResponse(resp, &results); err != nil {
+				return err
+			}
+			for _, result := range results {
+				if result.Error != "" {
+					plugin.Logger(ctx).Warn("ovh.getBatched", "path", path, "key", result.Key, "error", result.Error)
+					continue
+				}
+				streamItem(result.Key, result.Value)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}